@@ -71,6 +71,7 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	gravityparams "github.com/cosmos/gravity-bridge/module/app/params"
 	"github.com/cosmos/gravity-bridge/module/x/gravity"
+	gravityclient "github.com/cosmos/gravity-bridge/module/x/gravity/client"
 	"github.com/cosmos/gravity-bridge/module/x/gravity/keeper"
 	gravitytypes "github.com/cosmos/gravity-bridge/module/x/gravity/types"
 	ibctransfer "github.com/cosmos/ibc-go/modules/apps/transfer"
@@ -124,6 +125,9 @@ var (
 			distrclient.ProposalHandler,
 			upgradeclient.ProposalHandler,
 			upgradeclient.CancelProposalHandler,
+			gravityclient.ResumeBridgeProposalHandler,
+			gravityclient.BridgePauseProposalHandler,
+			gravityclient.CancelPendingSendToEthereumProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -209,6 +213,9 @@ type Gravity struct {
 	// Module Manager
 	mm *module.Manager
 
+	// module configurator
+	configurator module.Configurator
+
 	// simulation manager
 	sm *module.SimulationManager
 }
@@ -348,6 +355,19 @@ func NewGravityApp(
 		app.BaseApp,
 	)
 
+	// the gravity keeper is built before its staking hooks are registered, otherwise the hooks belong to an
+	// empty keeper, and it holds a pointer to the staking keeper so that its slashes run the staking hooks
+	app.gravityKeeper = keeper.NewKeeper(
+		appCodec,
+		keys[gravitytypes.StoreKey],
+		app.GetSubspace(gravitytypes.ModuleName),
+		app.accountKeeper,
+		&stakingKeeper,
+		app.bankKeeper,
+		app.slashingKeeper,
+		sdk.DefaultPowerReduction,
+	)
+
 	app.stakingKeeper = *stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(
 			app.distrKeeper.Hooks(),
@@ -370,7 +390,8 @@ func NewGravityApp(
 		AddRoute(paramsproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.ibcKeeper.ClientKeeper))
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.ibcKeeper.ClientKeeper)).
+		AddRoute(gravitytypes.RouterKey, gravity.NewGravityProposalHandler(app.gravityKeeper))
 
 	app.govKeeper = govkeeper.NewKeeper(
 		appCodec,
@@ -401,17 +422,6 @@ func NewGravityApp(
 	)
 	app.evidenceKeeper = *evidenceKeeper
 
-	app.gravityKeeper = keeper.NewKeeper(
-		appCodec,
-		keys[gravitytypes.StoreKey],
-		app.GetSubspace(gravitytypes.ModuleName),
-		app.accountKeeper,
-		stakingKeeper,
-		app.bankKeeper,
-		app.slashingKeeper,
-		sdk.DefaultPowerReduction,
-	)
-
 	var skipGenesisInvariants = cast.ToBool(appOpts.Get(crisis.FlagSkipGenesisInvariants))
 
 	app.mm = module.NewManager(
@@ -479,8 +489,10 @@ func NewGravityApp(
 		params.NewAppModule(app.paramsKeeper),
 		transferModule,
 		gravity.NewAppModule(
+			appCodec,
 			app.gravityKeeper,
 			app.bankKeeper,
+			app.accountKeeper,
 		),
 	)
 
@@ -520,7 +532,9 @@ func NewGravityApp(
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
-	app.mm.RegisterServices(module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter()))
+	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
+	app.mm.RegisterServices(app.configurator)
+	app.registerUpgradeHandlers()
 
 	app.sm = module.NewSimulationManager(
 		auth.NewAppModule(appCodec, app.accountKeeper, authsims.RandomGenesisAccounts),
@@ -535,6 +549,7 @@ func NewGravityApp(
 		evidence.NewAppModule(app.evidenceKeeper),
 		ibc.NewAppModule(app.ibcKeeper),
 		transferModule,
+		gravity.NewAppModule(appCodec, app.gravityKeeper, app.bankKeeper, app.accountKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -600,6 +615,7 @@ func (app *Gravity) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 	if err := tmjson.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
 		panic(err)
 	}
+	app.upgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
@@ -0,0 +1,26 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestGravityStakingHooks(t *testing.T) {
+	app := NewGravityApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), EmptyAppOptions{})
+	appState, err := json.Marshal(NewDefaultGenesisState())
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{AppStateBytes: appState})
+	ctx := app.NewContext(false, tmproto.Header{Height: 10})
+
+	// a validator starting to unbond reaches the gravity keeper through the staking hooks, which makes the
+	// next end blocker create a signer set tx
+	app.stakingKeeper.AfterValidatorBeginUnbonding(ctx, sdk.ConsAddress{}, sdk.ValAddress{})
+	require.Equal(t, uint64(10), app.gravityKeeper.GetLastUnbondingBlockHeight(ctx))
+}
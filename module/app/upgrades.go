@@ -0,0 +1,26 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	gravitytypes "github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// UpgradeName is the name of the upgrade plan that migrates the gravity store to consensus version 2
+const UpgradeName = "gravity-v2"
+
+// registerUpgradeHandlers sets the handlers of the upgrade plans known to this binary
+func (app *Gravity) registerUpgradeHandlers() {
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeName, func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// chains started before the version map was stored in InitChainer have none, running the migrations
+		// with an empty map would init the genesis of every module again, so start from the current versions
+		// with gravity at the only version it had
+		if len(fromVM) == 0 {
+			fromVM = app.mm.GetVersionMap()
+			fromVM[gravitytypes.ModuleName] = 1
+		}
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+}
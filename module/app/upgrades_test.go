@@ -0,0 +1,104 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity"
+	"github.com/cosmos/gravity-bridge/module/x/gravity/keeper"
+	gravitytypes "github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// v1Params are the keys of the params of version 1 of the gravity module
+var v1Params = map[string]bool{
+	string(gravitytypes.ParamsStoreKeyGravityID):                              true,
+	string(gravitytypes.ParamsStoreKeyContractHash):                           true,
+	string(gravitytypes.ParamsStoreKeyBridgeContractAddress):                  true,
+	string(gravitytypes.ParamsStoreKeyBridgeContractChainID):                  true,
+	string(gravitytypes.ParamsStoreKeySignedSignerSetTxsWindow):               true,
+	string(gravitytypes.ParamsStoreKeySignedBatchesWindow):                    true,
+	string(gravitytypes.ParamsStoreKeyEthereumSignaturesWindow):               true,
+	string(gravitytypes.ParamsStoreKeyTargetEthTxTimeout):                     true,
+	string(gravitytypes.ParamsStoreKeyAverageBlockTime):                       true,
+	string(gravitytypes.ParamsStoreKeyAverageEthereumBlockTime):               true,
+	string(gravitytypes.ParamsStoreSlashFractionSignerSetTx):                  true,
+	string(gravitytypes.ParamsStoreSlashFractionBatch):                        true,
+	string(gravitytypes.ParamsStoreSlashFractionEthereumSignature):            true,
+	string(gravitytypes.ParamsStoreSlashFractionConflictingEthereumSignature): true,
+	string(gravitytypes.ParamStoreUnbondSlashingSignerSetTxsWindow):           true,
+}
+
+func TestUpgradeHandler(t *testing.T) {
+	for _, storedVersionMap := range []bool{true, false} {
+		storedVersionMap := storedVersionMap
+		t.Run(map[bool]string{true: "stored version map", false: "no version map"}[storedVersionMap], func(t *testing.T) {
+			app := NewGravityApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), EmptyAppOptions{})
+			appState, err := json.Marshal(NewDefaultGenesisState())
+			require.NoError(t, err)
+			app.InitChain(abci.RequestInitChain{AppStateBytes: appState})
+			ctx := app.NewContext(false, tmproto.Header{Height: 10})
+
+			// the store of a chain that ran version 1 of the gravity module
+			keeper.LoadV1StoreFixture(t, ctx, app.GetKey(gravitytypes.StoreKey), app.bankKeeper, "../x/gravity/keeper/testdata/v1_store.json")
+			paramsStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(gravitytypes.ModuleName+"/"))
+			for _, pair := range gravitytypes.DefaultParams().ParamSetPairs() {
+				if !v1Params[string(pair.Key)] {
+					paramsStore.Delete(pair.Key)
+				}
+			}
+			if storedVersionMap {
+				versions := app.mm.GetVersionMap()
+				versions[gravitytypes.ModuleName] = 1
+				app.upgradeKeeper.SetModuleVersionMap(ctx, versions)
+			} else {
+				versionStore := ctx.KVStore(app.GetKey(upgradetypes.StoreKey))
+				iter := sdk.KVStorePrefixIterator(versionStore, []byte{upgradetypes.VersionMapByte})
+				for ; iter.Valid(); iter.Next() {
+					versionStore.Delete(iter.Key())
+				}
+				iter.Close()
+				require.Empty(t, app.upgradeKeeper.GetModuleVersionMap(ctx))
+			}
+
+			app.upgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: UpgradeName, Height: 10})
+
+			require.Equal(t, app.mm.GetVersionMap(), app.upgradeKeeper.GetModuleVersionMap(ctx))
+			require.Equal(t, uint64(2), app.upgradeKeeper.GetModuleVersionMap(ctx)[gravitytypes.ModuleName])
+
+			// the signatures of the deleted outgoing txs are gone and the escrow is initialized
+			contract := common.HexToAddress(keeper.TokenContractAddrs[0])
+			require.Empty(t, app.gravityKeeper.GetEthereumSignatures(ctx, gravitytypes.MakeSignerSetTxKey(1)))
+			require.Empty(t, app.gravityKeeper.GetEthereumSignatures(ctx, gravitytypes.MakeBatchTxKey(contract, 1)))
+			require.Len(t, app.gravityKeeper.GetEthereumSignatures(ctx, gravitytypes.MakeSignerSetTxKey(2)), 2)
+			require.Equal(t, sdk.NewInt(897), app.gravityKeeper.GetERC20Escrow(ctx, contract))
+
+			// the params added after version 1 have their default values
+			params := app.gravityKeeper.GetParams(ctx)
+			require.Equal(t, gravitytypes.DefaultParams().EventVoteThreshold, params.EventVoteThreshold)
+			require.Equal(t, gravitytypes.DefaultParams().SignedContractCallTxsWindow, params.SignedContractCallTxsWindow)
+			require.Equal(t, gravitytypes.DefaultParams().MaxBatchSize, params.MaxBatchSize)
+
+			// the vote records written by version 1 are tallied, and pruned once the window after the upgrade
+			// has passed
+			require.NotPanics(t, func() { gravity.EndBlocker(ctx, app.gravityKeeper) })
+			ctx = ctx.WithBlockHeight(10 + int64(params.EthereumSignaturesWindow) + 1)
+			require.NotPanics(t, func() {
+				gravity.BeginBlocker(ctx, app.gravityKeeper)
+				gravity.EndBlocker(ctx, app.gravityKeeper)
+			})
+			require.Equal(t, uint64(10), app.gravityKeeper.GetEthereumEventSummary(ctx, 1).Height)
+			require.Empty(t, app.gravityKeeper.GetEthereumEventVoteRecordsByNonce(ctx, 1))
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/spf13/cobra"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/decoder"
+	"github.com/cosmos/gravity-bridge/module/x/gravity/keeper"
+	gravitytypes "github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+const (
+	flagGenesis = "genesis"
+	flagHeight  = "height"
+	flagPrefix  = "prefix"
+	flagMatch   = "match"
+)
+
+// debugCmd returns the sdk debug commands extended with the gravity ones
+func debugCmd() *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(GravityStoreCmd())
+	return cmd
+}
+
+// GravityStoreCmd prints the decoded entries of the gravity store of a node or of an exported genesis
+func GravityStoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gravity-store",
+		Short: "Print the decoded entries of the gravity store",
+		Long: `Print the decoded key and value of the entries of the gravity store, read from the
+application database of a stopped node or from the gravity state of an exported genesis.
+
+The entries can be filtered by store prefix, named after the key constants of the gravity
+module (e.g. SendToEthereum) or given as a hex byte, and by a string their decoded key or
+value contains.
+
+Example:
+	gravity debug gravity-store --prefix SendToEthereum,OutgoingTx
+	gravity debug gravity-store --genesis exported.json --match 0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5 --output json
+	`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			genesisFile, _ := cmd.Flags().GetString(flagGenesis)
+			height, _ := cmd.Flags().GetInt64(flagHeight)
+			prefixNames, _ := cmd.Flags().GetStringSlice(flagPrefix)
+			match, _ := cmd.Flags().GetString(flagMatch)
+			output, _ := cmd.Flags().GetString(tmcli.OutputFlag)
+
+			if output != "text" && output != "json" {
+				return fmt.Errorf("invalid output format %s, expected text or json", output)
+			}
+
+			var prefixes [][]byte
+			for _, name := range prefixNames {
+				prefix, err := decoder.ParsePrefix(name)
+				if err != nil {
+					return err
+				}
+				prefixes = append(prefixes, []byte{prefix})
+			}
+			if len(prefixes) == 0 {
+				prefixes = [][]byte{nil}
+			}
+
+			var (
+				kvStore sdk.KVStore
+				err     error
+			)
+			if genesisFile != "" {
+				if height != 0 {
+					return fmt.Errorf("the --%s flag can't be used with --%s", flagHeight, flagGenesis)
+				}
+				kvStore, err = loadGenesisGravityStore(clientCtx, genesisFile)
+			} else {
+				var db dbm.DB
+				dataDir := filepath.Join(server.GetServerContextFromCmd(cmd).Config.RootDir, "data")
+				db, err = sdk.NewLevelDB("application", dataDir)
+				if err != nil {
+					return err
+				}
+				defer db.Close()
+				kvStore, err = loadNodeGravityStore(db, height)
+			}
+			if err != nil {
+				return err
+			}
+
+			for _, prefix := range prefixes {
+				if err := printGravityStore(cmd, clientCtx, kvStore, prefix, match, output); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagGenesis, "", "Read the gravity store from an exported genesis file instead of the node data directory")
+	cmd.Flags().Int64(flagHeight, 0, "Read the gravity store of the node at the height, the latest height if zero")
+	cmd.Flags().StringSlice(flagPrefix, nil, "Only print the entries of the store prefixes, by name or hex byte")
+	cmd.Flags().String(flagMatch, "", "Only print the entries whose decoded key or value contains the string")
+	cmd.Flags().String(tmcli.OutputFlag, "text", "Output format (text|json)")
+
+	return cmd
+}
+
+// loadNodeGravityStore loads the gravity store of the application database at the height
+func loadNodeGravityStore(db dbm.DB, height int64) (sdk.KVStore, error) {
+	key := sdk.NewKVStoreKey(gravitytypes.StoreKey)
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+
+	var err error
+	if height == 0 {
+		err = ms.LoadLatestVersion()
+	} else {
+		err = ms.LoadVersion(height)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load application store: %w", err)
+	}
+
+	return ms.GetKVStore(key), nil
+}
+
+// loadGenesisGravityStore initializes an in memory gravity store with the gravity state of the genesis file
+func loadGenesisGravityStore(clientCtx client.Context, genesisFile string) (sdk.KVStore, error) {
+	genDoc, err := tmtypes.GenesisDocFromFile(genesisFile)
+	if err != nil {
+		return nil, err
+	}
+	appState, err := genutiltypes.GenesisStateFromGenDoc(*genDoc)
+	if err != nil {
+		return nil, err
+	}
+
+	var genesis gravitytypes.GenesisState
+	if err := clientCtx.Codec.UnmarshalJSON(appState[gravitytypes.ModuleName], &genesis); err != nil {
+		return nil, fmt.Errorf("unmarshal gravity genesis: %w", err)
+	}
+	if err := genesis.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid gravity genesis: %w", err)
+	}
+
+	key := sdk.NewKVStoreKey(gravitytypes.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, nil)
+	if err := ms.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+
+	// the genesis only touches the gravity store and its params, the other keepers aren't needed
+	subspace := paramstypes.NewSubspace(clientCtx.Codec, clientCtx.LegacyAmino, paramsKey, paramsTKey, gravitytypes.ModuleName)
+	k := keeper.NewKeeper(clientCtx.Codec, key, subspace, nil, nil, nil, nil, sdk.DefaultPowerReduction)
+	keeper.InitGenesis(sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger()), k, genesis)
+
+	return ms.GetKVStore(key), nil
+}
+
+// printGravityStore prints the decoded entries of the store under the prefix that contain the match
+func printGravityStore(
+	cmd *cobra.Command, clientCtx client.Context, kvStore sdk.KVStore, prefix []byte, match, output string,
+) error {
+	iter := sdk.KVStorePrefixIterator(kvStore, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		entry, err := decoder.Decode(clientCtx.Codec, iter.Key(), iter.Value())
+		if err != nil {
+			return err
+		}
+
+		if match != "" && !strings.Contains(entry.String(), match) {
+			continue
+		}
+
+		line := entry.String()
+		if output == "json" {
+			bz, err := json.Marshal(entry)
+			if err != nil {
+				return err
+			}
+			line = string(bz)
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), line)
+	}
+
+	return nil
+}
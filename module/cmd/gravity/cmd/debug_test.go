@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/gravity-bridge/module/app"
+	"github.com/cosmos/gravity-bridge/module/x/gravity/decoder"
+	gravitytypes "github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+func TestGravityStoreCmdGenesis(t *testing.T) {
+	encodingConfig := app.MakeEncodingConfig()
+	clientCtx := client.Context{}.
+		WithCodec(encodingConfig.Marshaler).
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
+		WithLegacyAmino(encodingConfig.Amino)
+
+	valAddr := sdk.ValAddress([]byte("validator___________"))
+	orchAddr := sdk.AccAddress([]byte("orchestrator________"))
+	contract := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+
+	genesis := gravitytypes.DefaultGenesisState()
+	genesis.DelegateKeys = []*gravitytypes.MsgDelegateKeys{{
+		ValidatorAddress:    valAddr.String(),
+		OrchestratorAddress: orchAddr.String(),
+		EthereumAddress:     "0x2a24af0501A534Fca004EE1bD667B783f205A546",
+	}}
+	genesis.PausedTokenContracts = []string{contract}
+	genesis.LastSendToEthereumId = 12
+
+	appState, err := json.Marshal(map[string]json.RawMessage{
+		gravitytypes.ModuleName: clientCtx.Codec.MustMarshalJSON(genesis),
+	})
+	require.NoError(t, err)
+	genesisFile := filepath.Join(t.TempDir(), "genesis.json")
+	genDoc := tmtypes.GenesisDoc{ChainID: "gravity-test", AppState: appState}
+	require.NoError(t, genDoc.SaveAs(genesisFile))
+
+	execute := func(args ...string) []string {
+		cmd := GravityStoreCmd()
+		buf := bytes.NewBuffer(nil)
+		cmd.SetOut(buf)
+		cmd.SetArgs(append([]string{"--genesis", genesisFile}, args...))
+		require.NoError(t, cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)))
+		return strings.Split(strings.TrimSpace(buf.String()), "\n")
+	}
+
+	lines := execute("--prefix", "LastSendToEthereumID,PausedToken")
+	require.Equal(t, []string{
+		"LastSendToEthereumID[] 12",
+		"PausedToken[token_contract=" + contract + "] true",
+	}, lines)
+
+	lines = execute("--match", orchAddr.String(), "--output", "json")
+	require.Len(t, lines, 2)
+	var entry decoder.Entry
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	require.Equal(t, "OrchestratorValidatorAddress", entry.Prefix)
+	require.Equal(t, []decoder.Field{{Name: "orchestrator", Value: orchAddr.String()}}, entry.Key)
+	require.JSONEq(t, `"`+valAddr.String()+`"`, string(entry.Value))
+}
+
+func TestLoadNodeGravityStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	key := sdk.NewKVStoreKey(gravitytypes.StoreKey)
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ms.GetKVStore(key).Set([]byte{gravitytypes.LastSendToEthereumIDKey}, sdk.Uint64ToBigEndian(1))
+	ms.Commit()
+	ms.GetKVStore(key).Set([]byte{gravitytypes.LastSendToEthereumIDKey}, sdk.Uint64ToBigEndian(2))
+	ms.Commit()
+
+	kvStore, err := loadNodeGravityStore(db, 0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.Uint64ToBigEndian(2), kvStore.Get([]byte{gravitytypes.LastSendToEthereumIDKey}))
+
+	kvStore, err = loadNodeGravityStore(db, 1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.Uint64ToBigEndian(1), kvStore.Get([]byte{gravitytypes.LastSendToEthereumIDKey}))
+
+	_, err = loadNodeGravityStore(db, 3)
+	require.Error(t, err)
+}
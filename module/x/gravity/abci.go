@@ -1,8 +1,6 @@
 package gravity
 
 import (
-	"sort"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -19,34 +17,33 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	cleanupTimedOutBatchTxs(ctx, k)
 	cleanupTimedOutContractCallTxs(ctx, k)
 	createSignerSetTxs(ctx, k)
+	k.ReleasePendingSendToEthereums(ctx)
+	k.RefundExpiredSendToEthereums(ctx)
 	createBatchTxs(ctx, k)
 	pruneSignerSetTxs(ctx, k)
+	pruneEthereumEventVoteRecords(ctx, k)
 }
 
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	outgoingTxSlashing(ctx, k)
+	contractCallTxSlashing(ctx, k)
+	eventVoteRecordSlashing(ctx, k)
 	eventVoteRecordTally(ctx, k)
 }
 
 func createBatchTxs(ctx sdk.Context, k keeper.Keeper) {
-	// TODO: this needs some more work, is super naieve
-	if ctx.BlockHeight()%10 == 0 {
-		cm := map[string]bool{}
-		k.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
-			cm[ste.Erc20Token.Contract] = true
-			return false
-		})
-
-		var contracts []string
-		for k := range cm {
-			contracts = append(contracts, k)
-		}
+	if k.IsBridgeHalted(ctx) {
+		return
+	}
 
-		for _, c := range contracts {
-			// NOTE: this doesn't emit events which would be helpful for client processes
-			k.BuildBatchTx(ctx, common.HexToAddress(c), 100)
+	for _, contract := range k.GetUnbatchedTokenContracts(ctx) {
+		if k.IsTokenPaused(ctx, contract) || !k.BatchCreationPolicy.ShouldCreateBatchTx(ctx, contract) {
+			continue
 		}
+
+		// NOTE: this doesn't emit events which would be helpful for client processes
+		k.BuildBatchTx(ctx, contract, k.BatchCreationPolicy.MaxBatchSize(ctx, contract))
 	}
 }
 
@@ -103,49 +100,26 @@ func pruneSignerSetTxs(ctx sdk.Context, k keeper.Keeper) {
 	}
 }
 
+// pruneEthereumEventVoteRecords removes the vote records of the events observed before the ethereum
+// signatures window, once validators were slashed for not voting on them
+func pruneEthereumEventVoteRecords(ctx sdk.Context, k keeper.Keeper) {
+	params := k.GetParams(ctx)
+	if uint64(ctx.BlockHeight()) <= params.EthereumSignaturesWindow {
+		return
+	}
+	k.PruneEthereumEventVoteRecords(ctx, uint64(ctx.BlockHeight())-params.EthereumSignaturesWindow)
+}
+
 // Iterate over all attestations currently being voted on in order of nonce and
 // "Observe" those who have passed the threshold. Break the loop once we see
 // an attestation that has not passed the threshold
 func eventVoteRecordTally(ctx sdk.Context, k keeper.Keeper) {
-	attmap := k.GetEthereumEventVoteRecordMapping(ctx)
-
-	// We make a slice with all the event nonces that are in the attestation mapping
-	keys := make([]uint64, 0, len(attmap))
-	for k := range attmap {
-		keys = append(keys, k)
-	}
-	// Then we sort it
-	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
-
-	// This iterates over all keys (event nonces) in the attestation mapping. Each value contains
-	// a slice with one or more attestations at that event nonce. There can be multiple attestations
-	// at one event nonce when validators disagree about what event happened at that nonce.
-	for _, nonce := range keys {
-		// This iterates over all attestations at a particular event nonce.
-		// They are ordered by when the first attestation at the event nonce was received.
-		// This order is not important.
-		for _, att := range attmap[nonce] {
-			// We check if the event nonce is exactly 1 higher than the last attestation that was
-			// observed. If it is not, we just move on to the next nonce. This will skip over all
-			// attestations that have already been observed.
-			//
-			// Once we hit an event nonce that is one higher than the last observed event, we stop
-			// skipping over this conditional and start calling tryAttestation (counting votes)
-			// Once an attestation at a given event nonce has enough votes and becomes observed,
-			// every other attestation at that nonce will be skipped, since the lastObservedEventNonce
-			// will be incremented.
-			//
-			// Then we go to the next event nonce in the attestation mapping, if there is one. This
-			// nonce will once again be one higher than the lastObservedEventNonce.
-			// If there is an attestation at this event nonce which has enough votes to be observed,
-			// we skip the other attestations and move on to the next nonce again.
-			// If no attestation becomes observed, when we get to the next nonce, every attestation in
-			// it will be skipped. The same will happen for every nonce after that.
-			if nonce == uint64(k.GetLastObservedEventNonce(ctx))+1 {
-				k.TryEventVoteRecord(ctx, att)
-			}
-		}
+	// votes keep being recorded while the bridge is halted, they are tallied once it resumes
+	if k.IsBridgeHalted(ctx) {
+		return
 	}
+
+	k.TallyEthereumEventVoteRecords(ctx)
 }
 
 // cleanupTimedOutBatchTxs deletes batches that have passed their expiration on Ethereum
@@ -190,6 +164,24 @@ func cleanupTimedOutContractCallTxs(ctx sdk.Context, k keeper.Keeper) {
 	})
 }
 
+// valInfo holds a validator along with its signing info
+type valInfo struct {
+	val   stakingtypes.Validator
+	exist bool
+	sigs  slashingtypes.ValidatorSigningInfo
+	cons  sdk.ConsAddress
+}
+
+// getBondedValidatorInfos returns the signing info for each bonded validator
+func getBondedValidatorInfos(ctx sdk.Context, k keeper.Keeper) (out []valInfo) {
+	for _, val := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		consAddr, _ := val.GetConsAddr()
+		sigs, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		out = append(out, valInfo{val, exist, sigs, consAddr})
+	}
+	return
+}
+
 func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 	params := k.GetParams(ctx)
 	maxHeight := uint64(0)
@@ -204,21 +196,7 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 		return
 	}
 
-	// get signing info for each validator
-	type valInfo struct {
-		val   stakingtypes.Validator
-		exist bool
-		sigs  slashingtypes.ValidatorSigningInfo
-		cons  sdk.ConsAddress
-	}
-
-	var valInfos []valInfo
-
-	for _, val := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
-		consAddr, _ := val.GetConsAddr()
-		sigs, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
-		valInfos = append(valInfos, valInfo{val, exist, sigs, consAddr})
-	}
+	valInfos := getBondedValidatorInfos(ctx, k)
 
 	var unbondingValInfos []valInfo
 
@@ -240,44 +218,21 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 	}
 
 	for _, otx := range usotxs {
-		// SLASH BONDED VALIDATORS who didn't sign batch txs
-		signatures := k.GetEthereumSignatures(ctx, otx.GetStoreIndex())
-		for _, valInfo := range valInfos {
-			// Don't slash validators who joined after outgoingtx is created
-			if valInfo.exist && valInfo.sigs.StartHeight < int64(otx.GetCosmosHeight()) {
-				if _, ok := signatures[valInfo.val.GetOperator().String()]; !ok {
-					if !valInfo.val.IsJailed() {
-						k.StakingKeeper.Slash(
-							ctx,
-							valInfo.cons,
-							ctx.BlockHeight(),
-							valInfo.val.ConsensusPower(k.PowerReduction),
-							params.SlashFractionBatch,
-						)
-						k.StakingKeeper.Jail(ctx, valInfo.cons)
-					}
-				}
-			}
+		slashFraction := params.SlashFractionBatch
+		if _, ok := otx.(*types.SignerSetTx); ok {
+			slashFraction = params.SlashFractionSignerSetTx
 		}
 
+		// SLASH BONDED VALIDATORS who didn't sign outgoing txs
+		signatures := slashUnsignedOutgoingTx(ctx, k, otx, valInfos, slashFraction)
+
 		if sstx, ok := otx.(*types.SignerSetTx); ok {
 			for _, valInfo := range unbondingValInfos {
 				// Only slash validators who joined after valset is created and they are unbonding and UNBOND_SLASHING_WINDOW didn't pass
 				if valInfo.exist && valInfo.sigs.StartHeight < int64(sstx.Nonce) && valInfo.val.IsUnbonding() && sstx.Height < uint64(valInfo.val.UnbondingHeight)+params.UnbondSlashingSignerSetTxsWindow {
 					// Check if validator has confirmed valset or not
-					if _, found := signatures[valInfo.val.GetOperator().String()]; !found {
-						if !valInfo.val.IsJailed() {
-							// TODO: do we want to slash jailed validators
-							k.StakingKeeper.Slash(
-								ctx,
-								valInfo.cons,
-								ctx.BlockHeight(),
-								valInfo.val.ConsensusPower(k.PowerReduction),
-								params.SlashFractionSignerSetTx,
-							)
-							k.StakingKeeper.Jail(ctx, valInfo.cons)
-						}
-					}
+					_, signed := signatures[valInfo.val.GetOperator().String()]
+					handleValidatorSignature(ctx, k, valInfo, !signed, params.SlashFractionSignerSetTx)
 				}
 			}
 		}
@@ -286,3 +241,113 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 		k.SetLastSlashedOutgoingTxBlockHeight(ctx, otx.GetCosmosHeight())
 	}
 }
+
+// contractCallTxSlashing slashes and jails bonded validators that did not sign a contract call tx
+// once it is older than the signed contract call txs window
+func contractCallTxSlashing(ctx sdk.Context, k keeper.Keeper) {
+	params := k.GetParams(ctx)
+	maxHeight := uint64(0)
+	if uint64(ctx.BlockHeight()) > params.SignedContractCallTxsWindow {
+		maxHeight = uint64(ctx.BlockHeight()) - params.SignedContractCallTxsWindow
+	} else {
+		return
+	}
+
+	usctxs := k.GetUnSlashedContractCallTxs(ctx, maxHeight)
+	if len(usctxs) == 0 {
+		return
+	}
+
+	valInfos := getBondedValidatorInfos(ctx, k)
+
+	lastSlashed := k.GetLastSlashedContractCallTxBlockHeight(ctx)
+	for _, cctx := range usctxs {
+		slashUnsignedOutgoingTx(ctx, k, cctx, valInfos, params.SlashFractionContractCallTx)
+
+		// contract call txs are not stored in height order, so keep the highest slashed height
+		if cctx.Height > lastSlashed {
+			lastSlashed = cctx.Height
+		}
+	}
+	k.SetLastSlashedContractCallTxBlockHeight(ctx, lastSlashed)
+}
+
+// slashUnsignedOutgoingTx records in each bonded validator's signing info whether it signed the outgoing tx,
+// slashes and jails the ones that dropped below the minimum signed ratio, and returns the signatures that were
+// found for the outgoing tx
+func slashUnsignedOutgoingTx(ctx sdk.Context, k keeper.Keeper, otx types.OutgoingTx, valInfos []valInfo, slashFraction sdk.Dec) map[string][]byte {
+	signatures := k.GetEthereumSignatures(ctx, otx.GetStoreIndex())
+	for _, valInfo := range valInfos {
+		// Don't slash validators who joined after outgoingtx is created
+		if valInfo.exist && valInfo.sigs.StartHeight < int64(otx.GetCosmosHeight()) {
+			_, signed := signatures[valInfo.val.GetOperator().String()]
+			handleValidatorSignature(ctx, k, valInfo, !signed, slashFraction)
+		}
+	}
+	return signatures
+}
+
+// handleValidatorSignature records a signature or a miss for the validator, and slashes and jails it
+// if it dropped below the minimum signed ratio of its window
+func handleValidatorSignature(ctx sdk.Context, k keeper.Keeper, valInfo valInfo, missed bool, slashFraction sdk.Dec) {
+	if !k.HandleValidatorSignature(ctx, valInfo.val.GetOperator(), missed) {
+		return
+	}
+
+	// the validator may have been jailed since valInfos was built
+	if !k.StakingKeeper.Validator(ctx, valInfo.val.GetOperator()).IsJailed() {
+		k.StakingKeeper.Slash(
+			ctx,
+			valInfo.cons,
+			ctx.BlockHeight(),
+			valInfo.val.ConsensusPower(k.PowerReduction),
+			slashFraction,
+		)
+		k.StakingKeeper.Jail(ctx, valInfo.cons)
+	}
+}
+
+// eventVoteRecordSlashing slashes and jails bonded validators that did not vote on an
+// accepted ethereum event once the record is older than the ethereum signatures window
+func eventVoteRecordSlashing(ctx sdk.Context, k keeper.Keeper) {
+	params := k.GetParams(ctx)
+	maxHeight := uint64(0)
+	if uint64(ctx.BlockHeight()) > params.EthereumSignaturesWindow {
+		maxHeight = uint64(ctx.BlockHeight()) - params.EthereumSignaturesWindow
+	} else {
+		return
+	}
+
+	usrecords := k.GetUnSlashedEthereumEventVoteRecords(ctx, maxHeight)
+	if len(usrecords) == 0 {
+		return
+	}
+
+	valInfos := getBondedValidatorInfos(ctx, k)
+
+	for _, record := range usrecords {
+		event, err := types.UnpackEvent(record.Event)
+		if err != nil {
+			panic(err)
+		}
+
+		// validators who voted for a different event at this nonce were slashed for the conflicting vote
+		// when the event was accepted, and aren't slashed for missing the vote as well
+		voted := make(map[string]bool)
+		for _, r := range k.GetEthereumEventVoteRecordsByNonce(ctx, event.GetEventNonce()) {
+			for _, v := range r.Votes {
+				voted[v.Validator] = true
+			}
+		}
+
+		for _, valInfo := range valInfos {
+			// Don't slash validators who joined after the event was observed
+			if valInfo.exist && valInfo.sigs.StartHeight < int64(record.Height) {
+				handleValidatorSignature(ctx, k, valInfo, !voted[valInfo.val.GetOperator().String()], params.SlashFractionEthereumSignature)
+			}
+		}
+
+		// then we set the latest slashed event nonce
+		k.SetLastSlashedEventNonce(ctx, event.GetEventNonce())
+	}
+}
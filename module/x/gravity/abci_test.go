@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/gravity-bridge/module/x/gravity"
 	"github.com/cosmos/gravity-bridge/module/x/gravity/keeper"
@@ -72,6 +73,10 @@ func TestSignerSetTxSlashing_SignerSetTxCreated_After_ValidatorBonded(t *testing
 	input, ctx := keeper.SetupFiveValChain(t)
 	pk := input.GravityKeeper
 	params := input.GravityKeeper.GetParams(ctx)
+	// missed signer set txs are slashed by their own fraction, not the batch one
+	params.SlashFractionSignerSetTx = sdk.NewDecWithPrec(2, 2)
+	pk.SetParams(ctx, params)
+	tokensBefore := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).GetTokens()
 
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedSignerSetTxsWindow) + 2)
 	signerSet := pk.CreateSignerSetTx(ctx)
@@ -91,6 +96,7 @@ func TestSignerSetTxSlashing_SignerSetTxCreated_After_ValidatorBonded(t *testing
 	// ensure that the  validator who is bonded before signer set tx is created is slashed
 	val := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
 	require.True(t, val.IsJailed())
+	require.Equal(t, tokensBefore.ToDec().Mul(sdk.OneDec().Sub(params.SlashFractionSignerSetTx)).TruncateInt(), val.GetTokens())
 
 	// ensure that the  validator who attested the signer set tx is not slashed.
 	val = input.StakingKeeper.Validator(ctx, keeper.ValAddrs[1])
@@ -161,6 +167,45 @@ func TestSignerSetTxSlashing_UnbondingValidator_UnbondWindow_NotExpired(t *testi
 	// check if tokens shouldn't be slashed for val2.
 }
 
+func TestSignerSetTxSlashing_UnbondingValidator_MissedSignaturesWithinWindow(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	params := gravityKeeper.GetParams(ctx)
+	params.SigningInfoWindow = 4
+	params.MinSignedPerWindow = sdk.NewDecWithPrec(5, 1)
+	gravityKeeper.SetParams(ctx, params)
+
+	// the first validator starts unbonding, and is unjailed so only its missed signer set txs can jail it
+	start := uint64(ctx.BlockHeight())
+	_, err := staking.NewHandler(input.StakingKeeper)(ctx, keeper.NewTestMsgUnDelegateValidator(keeper.ValAddrs[0], keeper.StakingAmount))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+	input.StakingKeeper.Unjail(ctx, sdk.ConsAddress(keeper.ConsPrivKeys[0].PubKey().Address()))
+	require.True(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).IsUnbonding())
+
+	// the unbonding validator never signs, the others sign every signer set tx
+	for nonce := start + 1; nonce <= start+3; nonce++ {
+		signerSet := &types.SignerSetTx{Nonce: nonce, Height: nonce}
+		gravityKeeper.SetOutgoingTx(ctx, signerSet)
+		for i, val := range keeper.ValAddrs[1:] {
+			gravityKeeper.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{
+				SignerSetNonce: nonce,
+				EthereumSigner: keeper.EthAddrs[i+1].String(),
+				Signature:      []byte("dummysig"),
+			}, val)
+		}
+
+		gravity.EndBlocker(ctx.WithBlockHeight(int64(nonce+params.SignedSignerSetTxsWindow+1)), gravityKeeper)
+
+		// the validator is only jailed once it misses more than half of its window
+		require.Equal(t, nonce == start+3, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).IsJailed())
+	}
+
+	info, found := gravityKeeper.GetGravitySigningInfo(ctx, keeper.ValAddrs[0])
+	require.True(t, found)
+	require.Equal(t, uint64(0), info.MissedCounter)
+}
+
 func TestBatchSlashing(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	gravityKeeper := input.GravityKeeper
@@ -314,3 +359,378 @@ func fundAccount(ctx sdk.Context, bankKeeper types.BankKeeper, addr sdk.AccAddre
 
 	return bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, amounts)
 }
+
+func TestEventVoteRecordSlashing(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	params := gravityKeeper.GetParams(ctx)
+	h := gravity.NewHandler(gravityKeeper)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// don't vote with the first validator, 2nd validator joined after the event was observed
+	validator := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[1])
+	valConsAddr, _ := validator.GetConsAddr()
+	input.SlashingKeeper.SetValidatorSigningInfo(ctx, valConsAddr, slashingtypes.ValidatorSigningInfo{StartHeight: ctx.BlockHeight() + 1})
+
+	event := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  keeper.TokenContractAddrs[0],
+		Amount:         sdk.NewInt(12),
+		EthereumSender: keeper.EthAddrs[0].String(),
+		CosmosReceiver: keeper.AccAddrs[0].String(),
+	}
+	eva, err := types.PackEvent(event)
+	require.NoError(t, err)
+
+	for i := range keeper.ValAddrs {
+		if i == 0 {
+			continue
+		}
+		_, err = h(ctx, &types.MsgSubmitEthereumEvent{Event: eva, Signer: keeper.AccAddrs[i].String()})
+		require.NoError(t, err)
+	}
+
+	gravity.EndBlocker(ctx, gravityKeeper)
+	record := gravityKeeper.GetEthereumEventVoteRecord(ctx, event.EventNonce, event.Hash())
+	require.True(t, record.Accepted)
+	require.EqualValues(t, ctx.BlockHeight(), record.Height)
+
+	// nothing is slashed while the record is within the window
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.EthereumSignaturesWindow))
+	gravity.EndBlocker(ctx, gravityKeeper)
+	require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).IsJailed())
+	require.Zero(t, gravityKeeper.GetLastSlashedEventNonce(ctx))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	gravity.EndBlocker(ctx, gravityKeeper)
+
+	// ensure that the validator who didn't vote is jailed and slashed
+	require.True(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).IsJailed())
+
+	// ensure that the validator who joined later and the voters are not jailed
+	for _, val := range keeper.ValAddrs[1:] {
+		require.False(t, input.StakingKeeper.Validator(ctx, val).IsJailed())
+	}
+
+	require.Equal(t, event.EventNonce, gravityKeeper.GetLastSlashedEventNonce(ctx))
+}
+
+func TestConflictingEventVoteSlashing(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	params := gravityKeeper.GetParams(ctx)
+	h := gravity.NewHandler(gravityKeeper)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	sendToCosmos := func(nonce uint64, amount int64) *types.SendToCosmosEvent {
+		return &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  keeper.TokenContractAddrs[0],
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: keeper.EthAddrs[0].String(),
+			CosmosReceiver: keeper.AccAddrs[0].String(),
+		}
+	}
+	vote := func(i int, ev types.EthereumEvent) []abci.Event {
+		eva, err := types.PackEvent(ev)
+		require.NoError(t, err)
+		res, err := h(ctx, &types.MsgSubmitEthereumEvent{Event: eva, Signer: keeper.AccAddrs[i].String()})
+		require.NoError(t, err)
+		return res.Events
+	}
+	conflictingVoters := func(events []abci.Event) (out []string) {
+		for _, e := range events {
+			if e.Type != types.EventTypeConflictingEventVote {
+				continue
+			}
+			for _, attr := range e.Attributes {
+				if string(attr.Key) == types.AttributeKeyValidatorAddr {
+					out = append(out, string(attr.Value))
+				}
+			}
+		}
+		return
+	}
+	slashed := func(tokens sdk.Int) sdk.Int {
+		return tokens.ToDec().Mul(sdk.OneDec().Sub(params.SlashFractionConflictingEthereumSignature)).TruncateInt()
+	}
+
+	// the first validator votes for a different event at the same nonce
+	event, conflicting := sendToCosmos(1, 12), sendToCosmos(1, 1000)
+	vote(0, conflicting)
+	for i := 1; i < len(keeper.ValAddrs); i++ {
+		vote(i, event)
+	}
+
+	// the validator who voted for the conflicting event is jailed, slashed and named in an event as soon as
+	// the event is accepted
+	tokensBefore := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).GetTokens()
+	gravity.EndBlocker(ctx, gravityKeeper)
+	require.True(t, gravityKeeper.GetEthereumEventVoteRecord(ctx, event.EventNonce, event.Hash()).Accepted)
+	require.False(t, gravityKeeper.GetEthereumEventVoteRecord(ctx, conflicting.EventNonce, conflicting.Hash()).Accepted)
+
+	val := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
+	require.True(t, val.IsJailed())
+	require.Equal(t, slashed(tokensBefore), val.GetTokens())
+	require.Equal(t, []string{keeper.ValAddrs[0].String()}, conflictingVoters(ctx.EventManager().ABCIEvents()))
+
+	for _, val := range keeper.ValAddrs[1:] {
+		require.False(t, input.StakingKeeper.Validator(ctx, val).IsJailed())
+	}
+
+	// a jailed validator isn't slashed again for a conflicting vote cast after the event was accepted
+	event = sendToCosmos(2, 12)
+	for i := 1; i < len(keeper.ValAddrs); i++ {
+		vote(i, event)
+	}
+	gravity.EndBlocker(ctx, gravityKeeper)
+	require.True(t, gravityKeeper.GetEthereumEventVoteRecord(ctx, event.EventNonce, event.Hash()).Accepted)
+
+	tokensBefore = input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).GetTokens()
+	require.Empty(t, conflictingVoters(vote(0, sendToCosmos(2, 1000))))
+	require.Equal(t, tokensBefore, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).GetTokens())
+
+	// once unjailed it is slashed and jailed right away for a late conflicting vote
+	input.StakingKeeper.Unjail(ctx, sdk.ConsAddress(keeper.ConsPrivKeys[0].PubKey().Address()))
+	event = sendToCosmos(3, 12)
+	for i := 1; i < len(keeper.ValAddrs); i++ {
+		vote(i, event)
+	}
+	gravity.EndBlocker(ctx, gravityKeeper)
+	require.True(t, gravityKeeper.GetEthereumEventVoteRecord(ctx, event.EventNonce, event.Hash()).Accepted)
+
+	events := vote(0, sendToCosmos(3, 1000))
+	val = input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
+	require.True(t, val.IsJailed())
+	require.True(t, val.GetTokens().LT(tokensBefore))
+	require.Equal(t, []string{keeper.ValAddrs[0].String()}, conflictingVoters(events))
+
+	// the conflicting voter isn't slashed again, nor for missing the votes, once the events leave the
+	// signatures window
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.EthereumSignaturesWindow) + 1)
+	tokensBefore = input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).GetTokens()
+	gravity.EndBlocker(ctx, gravityKeeper)
+	require.Equal(t, tokensBefore, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).GetTokens())
+	require.Equal(t, uint64(3), gravityKeeper.GetLastSlashedEventNonce(ctx))
+
+	for _, val := range keeper.ValAddrs[1:] {
+		require.False(t, input.StakingKeeper.Validator(ctx, val).IsJailed())
+	}
+}
+
+func TestContractCallTxSlashing(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	params := gravityKeeper.GetParams(ctx)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedContractCallTxsWindow) + 2)
+
+	cctx := &types.ContractCallTx{
+		InvalidationScope: []byte("an-invalidation-scope"),
+		InvalidationNonce: 1,
+		Address:           keeper.EthAddrs[0].String(),
+		Payload:           []byte("payload"),
+		Timeout:           1000,
+		Height:            uint64(ctx.BlockHeight() - int64(params.SignedContractCallTxsWindow+1)),
+	}
+	gravityKeeper.SetOutgoingTx(ctx, cctx)
+
+	// a contract call tx outside the batch window but still inside its own window isn't slashed
+	recent := &types.ContractCallTx{
+		InvalidationScope: []byte("an-invalidation-scope"),
+		InvalidationNonce: 2,
+		Address:           keeper.EthAddrs[0].String(),
+		Payload:           []byte("payload"),
+		Timeout:           1000,
+		Height:            uint64(ctx.BlockHeight() - int64(params.SignedBatchesWindow+1)),
+	}
+	require.True(t, params.SignedBatchesWindow < params.SignedContractCallTxsWindow)
+	gravityKeeper.SetOutgoingTx(ctx, recent)
+
+	for i, val := range keeper.ValAddrs {
+		if i == 0 {
+			// don't sign with first validator
+			continue
+		}
+		gravityKeeper.SetEthereumSignature(ctx, &types.ContractCallTxConfirmation{
+			InvalidationScope: cctx.InvalidationScope,
+			InvalidationNonce: cctx.InvalidationNonce,
+			EthereumSigner:    keeper.EthAddrs[i].String(),
+			Signature:         []byte("dummysig"),
+		}, val)
+	}
+
+	gravity.EndBlocker(ctx, gravityKeeper)
+
+	// ensure that the validator who didn't sign is jailed and slashed
+	require.True(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).IsJailed())
+	for _, val := range keeper.ValAddrs[1:] {
+		require.False(t, input.StakingKeeper.Validator(ctx, val).IsJailed())
+	}
+
+	require.Equal(t, cctx.Height, gravityKeeper.GetLastSlashedContractCallTxBlockHeight(ctx))
+	require.Zero(t, gravityKeeper.GetLastSlashedOutgoingTxBlockHeight(ctx))
+}
+
+func TestBatchSlashing_MissedSignaturesWithinWindow(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	params := gravityKeeper.GetParams(ctx)
+	params.SigningInfoWindow = 4
+	params.MinSignedPerWindow = sdk.NewDecWithPrec(5, 1)
+	gravityKeeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedBatchesWindow) + 2)
+
+	// the first validator never signs, the others sign every batch
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		batch := &types.BatchTx{
+			BatchNonce:    nonce,
+			Transactions:  []*types.SendToEthereum{},
+			TokenContract: keeper.TokenContractAddrs[0],
+			Height:        uint64(ctx.BlockHeight() - int64(params.SignedBatchesWindow+1)),
+		}
+		gravityKeeper.SetOutgoingTx(ctx, batch)
+
+		for i, val := range keeper.ValAddrs[1:] {
+			gravityKeeper.SetEthereumSignature(ctx, &types.BatchTxConfirmation{
+				BatchNonce:     batch.BatchNonce,
+				TokenContract:  keeper.TokenContractAddrs[0],
+				EthereumSigner: keeper.EthAddrs[i+1].String(),
+				Signature:      []byte("dummysig"),
+			}, val)
+		}
+
+		gravity.EndBlocker(ctx, gravityKeeper)
+
+		// the validator is only jailed once it misses more than half of its window
+		require.Equal(t, nonce == 3, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).IsJailed())
+		for _, val := range keeper.ValAddrs[1:] {
+			require.False(t, input.StakingKeeper.Validator(ctx, val).IsJailed())
+		}
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	}
+
+	for _, val := range keeper.ValAddrs[1:] {
+		info, found := gravityKeeper.GetGravitySigningInfo(ctx, val)
+		require.True(t, found)
+		require.Equal(t, uint64(0), info.MissedCounter)
+		require.Equal(t, uint64(3), info.IndexOffset)
+	}
+}
+
+func TestBatchTxCreationPolicy(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		allVouchers         = sdk.NewCoins(types.NewERC20Token(99999, myTokenContractAddr.Hex()).GravityCoin())
+	)
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	params := gravityKeeper.GetParams(ctx)
+	params.BatchCreationInterval = 10
+	params.MaxBatchSize = 2
+	params.MinBatchFees = []types.MinBatchFee{{TokenContract: myTokenContractAddr.Hex(), Amount: sdk.NewInt(10)}}
+	params.MaxOutstandingBatches = 2
+	gravityKeeper.SetParams(ctx, params)
+
+	batchTxs := func() (nonces []uint64) {
+		gravityKeeper.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+			nonces = append(nonces, otx.(*types.BatchTx).BatchNonce)
+			return false
+		})
+		return nonces
+	}
+
+	// the best paying sends pay 3 + 2, below the min batch fee
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3, 1)
+	gravity.BeginBlocker(ctx.WithBlockHeight(10), gravityKeeper)
+	require.Empty(t, batchTxs())
+
+	// they pay 6 + 5 now, but a batch is only created every 10 blocks
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 5, 6)
+	gravity.BeginBlocker(ctx.WithBlockHeight(11), gravityKeeper)
+	require.Empty(t, batchTxs())
+	gravity.BeginBlocker(ctx.WithBlockHeight(20), gravityKeeper)
+	require.Equal(t, []uint64{1}, batchTxs())
+
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 7, 8)
+	gravity.BeginBlocker(ctx.WithBlockHeight(30), gravityKeeper)
+	require.Equal(t, []uint64{2, 1}, batchTxs())
+
+	// two batches are waiting to be executed already
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 9, 10)
+	gravity.BeginBlocker(ctx.WithBlockHeight(40), gravityKeeper)
+	require.Equal(t, []uint64{2, 1}, batchTxs())
+
+	params.MaxOutstandingBatches = 0
+	gravityKeeper.SetParams(ctx, params)
+	gravity.BeginBlocker(ctx.WithBlockHeight(50), gravityKeeper)
+	require.Equal(t, []uint64{3, 2, 1}, batchTxs())
+	batch := gravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(myTokenContractAddr, 3)).(*types.BatchTx)
+	require.Len(t, batch.Transactions, 2)
+
+	// a chain can create batches its own way
+	gravityKeeper.BatchCreationPolicy = alwaysBatchPolicy{size: 1}
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 20)
+	gravity.BeginBlocker(ctx.WithBlockHeight(51), gravityKeeper)
+	require.Equal(t, []uint64{4, 3, 2, 1}, batchTxs())
+	batch = gravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(myTokenContractAddr, 4)).(*types.BatchTx)
+	require.Len(t, batch.Transactions, 1)
+}
+
+// alwaysBatchPolicy creates a batch tx of size sends in every block
+type alwaysBatchPolicy struct {
+	size int
+}
+
+func (p alwaysBatchPolicy) MaxBatchSize(sdk.Context, common.Address) int {
+	return p.size
+}
+
+func (p alwaysBatchPolicy) ShouldCreateBatchTx(sdk.Context, common.Address) bool {
+	return true
+}
+
+func TestEventVoteRecordSlashing_SeveralRecordsInOneBlock(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	params := gravityKeeper.GetParams(ctx)
+	h := gravity.NewHandler(gravityKeeper)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// the first validator doesn't vote on two events observed in the same block
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		event := &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  keeper.TokenContractAddrs[0],
+			Amount:         sdk.NewInt(12),
+			EthereumSender: keeper.EthAddrs[0].String(),
+			CosmosReceiver: keeper.AccAddrs[0].String(),
+		}
+		eva, err := types.PackEvent(event)
+		require.NoError(t, err)
+
+		for i := range keeper.ValAddrs[1:] {
+			_, err = h(ctx, &types.MsgSubmitEthereumEvent{Event: eva, Signer: keeper.AccAddrs[i+1].String()})
+			require.NoError(t, err)
+		}
+	}
+
+	gravity.EndBlocker(ctx, gravityKeeper)
+	require.Equal(t, uint64(2), gravityKeeper.GetLastObservedEventNonce(ctx))
+
+	// both records leave the window in the same block, the validator is jailed only once
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.EthereumSignaturesWindow) + 1)
+	require.NotPanics(t, func() { gravity.EndBlocker(ctx, gravityKeeper) })
+	require.True(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).IsJailed())
+	require.Equal(t, uint64(2), gravityKeeper.GetLastSlashedEventNonce(ctx))
+}
@@ -45,6 +45,13 @@ func GetQueryCmd() *cobra.Command {
 		CmdDelegateKeysByEthereumSigner(),
 		CmdDelegateKeysByOrchestrator(),
 		CmdDelegateKeys(),
+		CmdGravitySigningInfo(),
+		CmdGravitySigningInfos(),
+		CmdBridgePauseState(),
+		CmdOutflowRateLimitUsage(),
+		CmdPendingSendToEthereums(),
+		CmdEventVoteRecordTallies(),
+		CmdEthereumEventSummary(),
 	)
 
 	return gravityQueryCmd
@@ -805,6 +812,198 @@ func CmdDelegateKeys() *cobra.Command {
 	return cmd
 }
 
+func CmdGravitySigningInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-info [validator-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query a validator's missed ethereum signature and event vote counters",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			validatorAddress, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GravitySigningInfo(cmd.Context(), &types.GravitySigningInfoRequest{
+				ValidatorAddress: validatorAddress.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGravitySigningInfos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-infos",
+		Args:  cobra.NoArgs,
+		Short: "query the missed ethereum signature and event vote counters of all validators",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GravitySigningInfos(cmd.Context(), &types.GravitySigningInfosRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "signing-infos")
+	return cmd
+}
+
+func CmdBridgePauseState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-state",
+		Args:  cobra.NoArgs,
+		Short: "query whether the bridge is paused globally, the tokens it is paused for and whether it is halted",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BridgePauseState(cmd.Context(), &types.BridgePauseStateRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdOutflowRateLimitUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outflow-usage [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the outflow rate limit of a denom, the amount sent to ethereum in its window and the remaining quota",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OutflowRateLimitUsage(cmd.Context(), &types.OutflowRateLimitUsageRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdPendingSendToEthereums() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-send-to-ethereums",
+		Args:  cobra.NoArgs,
+		Short: "query the large sends to ethereum held until their release height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingSendToEthereums(cmd.Context(), &types.PendingSendToEthereumsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-send-to-ethereums")
+	return cmd
+}
+
+func CmdEventVoteRecordTallies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "event-vote-tallies",
+		Args:  cobra.NoArgs,
+		Short: "query the vote power of the ethereum events not yet observed against the power they need",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EventVoteRecordTallies(cmd.Context(), &types.EventVoteRecordTalliesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdEthereumEventSummary() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "event-summary [nonce]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the hash and observation height of the ethereum event observed at a nonce",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EthereumEventSummary(cmd.Context(), &types.EthereumEventSummaryRequest{EventNonce: nonce})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func newContextAndQueryClient(cmd *cobra.Command) (client.Context, types.QueryClient, error) {
 	clientCtx, err := client.GetClientQueryContext(cmd)
 	if err != nil {
@@ -1,13 +1,21 @@
 package cli
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/spf13/cobra"
@@ -15,6 +23,12 @@ import (
 	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
 )
 
+const (
+	FlagGlobal          = "global"
+	FlagResume          = "resume"
+	FlagExpiresAtHeight = "expires-at-height"
+)
+
 func GetTxCmd(storeKey string) *cobra.Command {
 	gravityTxCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -26,9 +40,12 @@ func GetTxCmd(storeKey string) *cobra.Command {
 
 	gravityTxCmd.AddCommand(
 		CmdSendToEthereum(),
+		CmdSendToEthereumMulti(),
 		CmdCancelSendToEthereum(),
+		CmdIncreaseSendToEthereumFee(),
 		CmdRequestBatchTx(),
 		CmdSetDelegateKeys(),
+		CmdCancelPendingSendToEthereum(),
 	)
 
 	return gravityTxCmd
@@ -66,7 +83,13 @@ func CmdSendToEthereum() *cobra.Command {
 				return err
 			}
 
+			expiresAtHeight, err := cmd.Flags().GetUint64(FlagExpiresAtHeight)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgSendToEthereum(from, common.HexToAddress(args[0]).Hex(), sendCoin, feeCoin)
+			msg.ExpiresAtHeight = expiresAtHeight
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -75,10 +98,135 @@ func CmdSendToEthereum() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagExpiresAtHeight, 0, "refund the send if it isn't batched by this height, zero to never expire")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+func CmdSendToEthereumMulti() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-to-ethereum-multi [recipients-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Send tokens from cosmos chain to many recipients on the connected ethereum chain",
+		Long: `Send tokens of a single denom from cosmos chain to many recipients on the connected ethereum chain
+in one message. The recipients are read from a CSV file with an ethereum recipient, send coins and fee
+coins per line, or from a JSON file if its name ends with .json.
+
+Example CSV file:
+	ethereum_recipient,amount,bridge_fee
+	0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7,1000ugrav,10ugrav
+	0x2a24af0501A534Fca004EE1bD667B783f205A546,2500ugrav,10ugrav
+
+Example JSON file:
+	[
+		{"ethereum_recipient": "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7", "amount": "1000ugrav", "bridge_fee": "10ugrav"},
+		{"ethereum_recipient": "0x2a24af0501A534Fca004EE1bD667B783f205A546", "amount": "2500ugrav", "bridge_fee": "10ugrav"}
+	]
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			recipients, err := readSendToEthereumRecipients(args[0])
+			if err != nil {
+				return err
+			}
+
+			expiresAtHeight, err := cmd.Flags().GetUint64(FlagExpiresAtHeight)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendToEthereumMulti(from, recipients)
+			msg.ExpiresAtHeight = expiresAtHeight
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagExpiresAtHeight, 0, "refund the sends that aren't batched by this height, zero to never expire")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// readSendToEthereumRecipients reads the recipients of a MsgSendToEthereumMulti from a CSV or JSON file
+func readSendToEthereumRecipients(path string) ([]types.SendToEthereumRecipient, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var rows [][]string
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		var entries []struct {
+			EthereumRecipient string `json:"ethereum_recipient"`
+			Amount            string `json:"amount"`
+			BridgeFee         string `json:"bridge_fee"`
+		}
+		if err := json.NewDecoder(f).Decode(&entries); err != nil {
+			return nil, fmt.Errorf("parse recipients json: %w", err)
+		}
+		for _, entry := range entries {
+			rows = append(rows, []string{entry.EthereumRecipient, entry.Amount, entry.BridgeFee})
+		}
+	} else {
+		r := csv.NewReader(f)
+		r.FieldsPerRecord = 3
+		r.TrimLeadingSpace = true
+		r.Comment = '#'
+		for {
+			row, err := r.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("parse recipients csv: %w", err)
+			}
+			// the header line is optional
+			if len(rows) == 0 && row[0] == "ethereum_recipient" {
+				continue
+			}
+			rows = append(rows, row)
+		}
+	}
+
+	recipients := make([]types.SendToEthereumRecipient, len(rows))
+	for i, row := range rows {
+		if !common.IsHexAddress(row[0]) {
+			return nil, fmt.Errorf("recipient %d: must be a valid ethereum address got %s", i, row[0])
+		}
+
+		sendCoin, err := sdk.ParseCoinNormalized(row[1])
+		if err != nil {
+			return nil, fmt.Errorf("recipient %d: %w", i, err)
+		}
+
+		feeCoin, err := sdk.ParseCoinNormalized(row[2])
+		if err != nil {
+			return nil, fmt.Errorf("recipient %d: %w", i, err)
+		}
+
+		recipients[i] = types.SendToEthereumRecipient{
+			EthereumRecipient: common.HexToAddress(row[0]).Hex(),
+			Amount:            sendCoin,
+			BridgeFee:         feeCoin,
+		}
+	}
+
+	return recipients, nil
+}
+
 func CmdCancelSendToEthereum() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "cancel-send-to-ethereum [id]",
@@ -113,6 +261,79 @@ func CmdCancelSendToEthereum() *cobra.Command {
 	return cmd
 }
 
+func CmdIncreaseSendToEthereumFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "increase-send-to-ethereum-fee [id] [additional-fee-coins]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Add to the bridge fee of an unbatched ethereum send by id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			feeCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgIncreaseSendToEthereumFee(id, from, feeCoin)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdCancelPendingSendToEthereum() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-pending-send-to-ethereum [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a held large ethereum send by id as the withdrawal guardian",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelPendingSendToEthereum(id, from)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdRequestBatchTx() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "request-batch-tx [denom] [signer]",
@@ -188,3 +409,176 @@ the validator's address and operator account current nonce.`,
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdSubmitResumeBridgeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-bridge",
+		Args:  cobra.NoArgs,
+		Short: "Submit a proposal to resume a bridge halted on a mismatching signer set",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewResumeBridgeProposal(title, description)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSubmitBridgePauseProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-pause [token]...",
+		Short: "Submit a proposal to pause or resume the bridge for ERC20 contracts or denoms, or globally",
+		Long: `Submit a proposal to pause the bridge for the listed tokens, given as ERC20 contract addresses or denoms.
+Pass --global instead of tokens to pause the bridge for every token, and --resume to resume it instead.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			global, err := cmd.Flags().GetBool(FlagGlobal)
+			if err != nil {
+				return err
+			}
+
+			resume, err := cmd.Flags().GetBool(FlagResume)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewBridgePauseProposal(title, description, !resume, global, args)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(FlagGlobal, false, "pause or resume the bridge for every token")
+	cmd.Flags().Bool(FlagResume, false, "resume the bridge instead of pausing it")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSubmitCancelPendingSendToEthereumProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-pending-send-to-ethereum [id]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to cancel held large ethereum sends and refund their senders",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			ids := make([]uint64, len(args))
+			for i, arg := range args {
+				if ids[i], err = strconv.ParseUint(arg, 10, 64); err != nil {
+					return err
+				}
+			}
+
+			content := types.NewCancelPendingSendToEthereumProposal(title, description, ids)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/client/cli"
+)
+
+// ResumeBridgeProposalHandler is the governance proposal handler for resuming a halted bridge
+var ResumeBridgeProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitResumeBridgeProposal, emptyRestHandler)
+
+// BridgePauseProposalHandler is the governance proposal handler for pausing and resuming the bridge
+var BridgePauseProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitBridgePauseProposal, emptyRestHandler)
+
+// CancelPendingSendToEthereumProposalHandler is the governance proposal handler for cancelling held large sends to ethereum
+var CancelPendingSendToEthereumProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitCancelPendingSendToEthereumProposal, emptyRestHandler)
+
+func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unsupported-gravity",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for gravity proposals")
+		},
+	}
+}
@@ -0,0 +1,436 @@
+// Package decoder decodes the raw entries of the gravity store, mapping every key prefix of
+// types/key.go to the fields encoded in the key and to the value stored under it.
+package decoder
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// prefixNames names the prefixes of the gravity store after their constant in types/key.go
+var prefixNames = map[byte]string{
+	types.ValidatorEthereumAddressKey:        "ValidatorEthereumAddress",
+	types.OrchestratorValidatorAddressKey:    "OrchestratorValidatorAddress",
+	types.EthereumOrchestratorAddressKey:     "EthereumOrchestratorAddress",
+	types.EthereumSignatureKey:               "EthereumSignature",
+	types.EthereumEventVoteRecordKey:         "EthereumEventVoteRecord",
+	types.OutgoingTxKey:                      "OutgoingTx",
+	types.SendToEthereumKey:                  "SendToEthereum",
+	types.LastEventNonceByValidatorKey:       "LastEventNonceByValidator",
+	types.LastObservedEventNonceKey:          "LastObservedEventNonce",
+	types.LatestSignerSetTxNonceKey:          "LatestSignerSetTxNonce",
+	types.LastSlashedOutgoingTxBlockKey:      "LastSlashedOutgoingTxBlock",
+	types.LastSlashedSignerSetTxNonceKey:     "LastSlashedSignerSetTxNonce",
+	types.LastOutgoingBatchNonceKey:          "LastOutgoingBatchNonce",
+	types.LastSendToEthereumIDKey:            "LastSendToEthereumID",
+	types.LastEthereumBlockHeightKey:         "LastEthereumBlockHeight",
+	types.DenomToERC20Key:                    "DenomToERC20",
+	types.ERC20ToDenomKey:                    "ERC20ToDenom",
+	types.LastUnBondingBlockHeightKey:        "LastUnBondingBlockHeight",
+	types.LastObservedSignerSetKey:           "LastObservedSignerSet",
+	types.LastSlashedEventNonceKey:           "LastSlashedEventNonce",
+	types.PastEthereumSignatureCheckpointKey: "PastEthereumSignatureCheckpoint",
+	types.LastSlashedContractCallTxBlockKey:  "LastSlashedContractCallTxBlock",
+	types.GravitySigningInfoKey:              "GravitySigningInfo",
+	types.MissedSignatureBitArrayKey:         "MissedSignatureBitArray",
+	types.BridgeHaltedKey:                    "BridgeHalted",
+	types.BridgePausedKey:                    "BridgePaused",
+	types.PausedTokenKey:                     "PausedToken",
+	types.ParkedSendToCosmosEventKey:         "ParkedSendToCosmosEvent",
+	types.OutflowBucketKey:                   "OutflowBucket",
+	types.PendingSendToEthereumKey:           "PendingSendToEthereum",
+	types.EthereumEventSummaryKey:            "EthereumEventSummary",
+	types.ERC20EscrowKey:                     "ERC20Escrow",
+	types.SendToEthereumExpiryKey:            "SendToEthereumExpiry",
+	types.BadSignatureEvidenceActivationKey:  "BadSignatureEvidenceActivation",
+}
+
+// Field is a named field encoded in a store key
+type Field struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Entry is a gravity store entry with its key and value decoded
+type Entry struct {
+	Prefix string          `json:"prefix"`
+	Key    []Field         `json:"key"`
+	Value  json.RawMessage `json:"value"`
+}
+
+// String returns the entry on a single line, the key fields followed by the value
+func (e Entry) String() string {
+	fields := make([]string, len(e.Key))
+	for i, field := range e.Key {
+		fields[i] = field.Name + "=" + field.Value
+	}
+	return fmt.Sprintf("%s[%s] %s", e.Prefix, strings.Join(fields, " "), e.Value)
+}
+
+// PrefixName returns the name of the store prefix
+func PrefixName(prefix byte) (string, bool) {
+	name, ok := prefixNames[prefix]
+	return name, ok
+}
+
+// ParsePrefix returns the store prefix of a prefix name, case insensitive, or of a hex encoded prefix byte
+func ParsePrefix(s string) (byte, error) {
+	for prefix, name := range prefixNames {
+		if strings.EqualFold(name, s) {
+			return prefix, nil
+		}
+	}
+
+	prefix, err := strconv.ParseUint(strings.TrimPrefix(strings.ToLower(s), "0x"), 16, 8)
+	if err != nil {
+		return 0, fmt.Errorf("unknown gravity store prefix %s", s)
+	}
+	if _, ok := prefixNames[byte(prefix)]; !ok {
+		return 0, fmt.Errorf("unknown gravity store prefix %s", s)
+	}
+	return byte(prefix), nil
+}
+
+// Decode decodes the key and the value of a gravity store entry
+func Decode(cdc codec.Codec, key, value []byte) (Entry, error) {
+	if len(key) == 0 {
+		return Entry{}, fmt.Errorf("empty gravity store key")
+	}
+	name, ok := PrefixName(key[0])
+	if !ok {
+		return Entry{}, fmt.Errorf("invalid gravity key prefix %X", key[:1])
+	}
+
+	fields, err := DecodeKey(key)
+	if err != nil {
+		return Entry{}, fmt.Errorf("decode %s key %X: %w", name, key, err)
+	}
+	bz, err := DecodeValue(cdc, key[0], value)
+	if err != nil {
+		return Entry{}, fmt.Errorf("decode %s value: %w", name, err)
+	}
+
+	return Entry{Prefix: name, Key: fields, Value: bz}, nil
+}
+
+// DecodeKey returns the fields encoded in a gravity store key after its prefix
+func DecodeKey(key []byte) ([]Field, error) {
+	r := &keyReader{bz: key[1:]}
+
+	switch key[0] {
+	case types.ValidatorEthereumAddressKey,
+		types.LastEventNonceByValidatorKey,
+		types.GravitySigningInfoKey:
+		r.valAddress("validator", len(r.bz))
+
+	case types.OrchestratorValidatorAddressKey:
+		r.accAddress("orchestrator", len(r.bz))
+
+	case types.EthereumOrchestratorAddressKey:
+		r.ethAddress("ethereum_address")
+
+	case types.EthereumSignatureKey:
+		// the store index of the outgoing tx is length prefixed, the validator address is the rest of the key
+		bz, _ := r.next(r.length())
+		index := &keyReader{bz: bz}
+		index.storeIndex()
+		if index.err == nil && len(index.bz) != 0 {
+			index.err = fmt.Errorf("%d unexpected trailing bytes in store index", len(index.bz))
+		}
+		if index.err != nil {
+			return nil, index.err
+		}
+		if len(r.bz) == 0 {
+			return nil, fmt.Errorf("key too short")
+		}
+		r.fields = index.fields
+		r.valAddress("validator", len(r.bz))
+
+	case types.EthereumEventVoteRecordKey:
+		r.uint64("event_nonce")
+		r.hex("event_hash", len(r.bz))
+
+	case types.OutgoingTxKey:
+		r.storeIndex()
+
+	case types.SendToEthereumKey:
+		r.ethAddress("token_contract")
+		r.bigInt("fee_amount", 32)
+		r.uint64("id")
+
+	case types.DenomToERC20Key:
+		r.string("denom", len(r.bz))
+
+	case types.ERC20ToDenomKey:
+		r.string("erc20", len(r.bz))
+
+	case types.PastEthereumSignatureCheckpointKey:
+		r.hex("checkpoint", len(r.bz))
+
+	case types.MissedSignatureBitArrayKey:
+		r.valAddress("validator", r.length())
+		r.uint64("index")
+
+	case types.PausedTokenKey, types.ERC20EscrowKey:
+		r.ethAddress("token_contract")
+
+	case types.ParkedSendToCosmosEventKey, types.EthereumEventSummaryKey:
+		r.uint64("event_nonce")
+
+	case types.OutflowBucketKey:
+		r.string("denom", r.length())
+		r.uint64("bucket_start_height")
+
+	case types.PendingSendToEthereumKey:
+		r.uint64("release_height")
+		r.uint64("id")
+
+	case types.SendToEthereumExpiryKey:
+		r.uint64("expires_at_height")
+		r.uint64("id")
+
+	case types.LastObservedEventNonceKey,
+		types.LatestSignerSetTxNonceKey,
+		types.LastSlashedOutgoingTxBlockKey,
+		types.LastSlashedSignerSetTxNonceKey,
+		types.LastOutgoingBatchNonceKey,
+		types.LastSendToEthereumIDKey,
+		types.LastEthereumBlockHeightKey,
+		types.LastUnBondingBlockHeightKey,
+		types.LastObservedSignerSetKey,
+		types.LastSlashedEventNonceKey,
+		types.LastSlashedContractCallTxBlockKey,
+		types.BridgeHaltedKey,
+		types.BridgePausedKey,
+		types.BadSignatureEvidenceActivationKey:
+		// singletons have no fields
+
+	default:
+		return nil, fmt.Errorf("invalid gravity key prefix %X", key[:1])
+	}
+
+	if r.err == nil && len(r.bz) != 0 {
+		r.err = fmt.Errorf("%d unexpected trailing bytes", len(r.bz))
+	}
+	return r.fields, r.err
+}
+
+// DecodeValue returns the JSON encoding of the value stored under a gravity store prefix
+func DecodeValue(cdc codec.Codec, prefix byte, value []byte) (json.RawMessage, error) {
+	switch prefix {
+	case types.ValidatorEthereumAddressKey, types.DenomToERC20Key:
+		return json.Marshal(common.BytesToAddress(value).Hex())
+
+	case types.OrchestratorValidatorAddressKey:
+		return json.Marshal(sdk.ValAddress(value).String())
+
+	case types.EthereumOrchestratorAddressKey:
+		return json.Marshal(sdk.AccAddress(value).String())
+
+	case types.EthereumSignatureKey:
+		return json.Marshal(hex.EncodeToString(value))
+
+	case types.EthereumEventVoteRecordKey:
+		return unmarshalJSON(cdc, value, &types.EthereumEventVoteRecord{})
+
+	case types.OutgoingTxKey:
+		// outgoing txs are stored packed in an any
+		return unmarshalJSON(cdc, value, &codectypes.Any{})
+
+	case types.SendToEthereumKey:
+		return unmarshalJSON(cdc, value, &types.SendToEthereum{})
+
+	case types.LastEventNonceByValidatorKey,
+		types.LastObservedEventNonceKey,
+		types.LatestSignerSetTxNonceKey,
+		types.LastSlashedOutgoingTxBlockKey,
+		types.LastSlashedSignerSetTxNonceKey,
+		types.LastOutgoingBatchNonceKey,
+		types.LastSendToEthereumIDKey,
+		types.LastUnBondingBlockHeightKey,
+		types.LastSlashedEventNonceKey,
+		types.LastSlashedContractCallTxBlockKey,
+		types.BridgeHaltedKey:
+		if len(value) != 8 {
+			return nil, fmt.Errorf("invalid uint64 length %d", len(value))
+		}
+		return json.Marshal(sdk.BigEndianToUint64(value))
+
+	case types.LastEthereumBlockHeightKey:
+		return unmarshalJSON(cdc, value, &types.LatestEthereumBlockHeight{})
+
+	case types.ERC20ToDenomKey:
+		return json.Marshal(string(value))
+
+	case types.LastObservedSignerSetKey:
+		return unmarshalJSON(cdc, value, &types.SignerSetTx{})
+
+	case types.BadSignatureEvidenceActivationKey:
+		return unmarshalJSON(cdc, value, &types.BadSignatureEvidenceActivation{})
+
+	case types.PastEthereumSignatureCheckpointKey,
+		types.MissedSignatureBitArrayKey,
+		types.BridgePausedKey,
+		types.PausedTokenKey:
+		return json.Marshal(len(value) == 1 && value[0] == 0x1)
+
+	case types.GravitySigningInfoKey:
+		return unmarshalJSON(cdc, value, &types.GravitySigningInfo{})
+
+	case types.ParkedSendToCosmosEventKey:
+		return unmarshalJSON(cdc, value, &types.SendToCosmosEvent{})
+
+	case types.OutflowBucketKey, types.ERC20EscrowKey:
+		var amount sdk.Int
+		if err := amount.Unmarshal(value); err != nil {
+			return nil, err
+		}
+		return json.Marshal(amount)
+
+	case types.PendingSendToEthereumKey:
+		return unmarshalJSON(cdc, value, &types.PendingSendToEthereum{})
+
+	case types.EthereumEventSummaryKey:
+		return unmarshalJSON(cdc, value, &types.EthereumEventSummary{})
+
+	case types.SendToEthereumExpiryKey:
+		return unmarshalJSON(cdc, value, &types.ERC20Token{})
+
+	default:
+		return nil, fmt.Errorf("invalid gravity key prefix %X", []byte{prefix})
+	}
+}
+
+// NewDecodeStore returns a decoder function closure that decodes the KVPair's
+// Value to the JSON of the corresponding gravity type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		valueA, err := DecodeValue(cdc, kvA.Key[0], kvA.Value)
+		if err != nil {
+			panic(err)
+		}
+		valueB, err := DecodeValue(cdc, kvB.Key[0], kvB.Value)
+		if err != nil {
+			panic(err)
+		}
+		return fmt.Sprintf("%s\n%s", valueA, valueB)
+	}
+}
+
+func unmarshalJSON(cdc codec.Codec, value []byte, msg codec.ProtoMarshaler) (json.RawMessage, error) {
+	if err := cdc.Unmarshal(value, msg); err != nil {
+		return nil, err
+	}
+	return cdc.MarshalJSON(msg)
+}
+
+// keyReader consumes the fields of a store key, the first error stops the reading
+type keyReader struct {
+	bz     []byte
+	fields []Field
+	err    error
+}
+
+func (r *keyReader) next(n int) ([]byte, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+	if n < 0 || len(r.bz) < n {
+		r.err = fmt.Errorf("key too short")
+		return nil, false
+	}
+	bz := r.bz[:n]
+	r.bz = r.bz[n:]
+	return bz, true
+}
+
+func (r *keyReader) add(name, value string) {
+	r.fields = append(r.fields, Field{Name: name, Value: value})
+}
+
+// length reads the length prefix of a length prefixed field
+func (r *keyReader) length() int {
+	bz, ok := r.next(1)
+	if !ok {
+		return 0
+	}
+	return int(bz[0])
+}
+
+func (r *keyReader) uint64(name string) {
+	if bz, ok := r.next(8); ok {
+		r.add(name, strconv.FormatUint(sdk.BigEndianToUint64(bz), 10))
+	}
+}
+
+func (r *keyReader) bigInt(name string, n int) {
+	if bz, ok := r.next(n); ok {
+		r.add(name, new(big.Int).SetBytes(bz).String())
+	}
+}
+
+func (r *keyReader) ethAddress(name string) {
+	if bz, ok := r.next(common.AddressLength); ok {
+		r.add(name, common.BytesToAddress(bz).Hex())
+	}
+}
+
+func (r *keyReader) valAddress(name string, n int) {
+	if bz, ok := r.next(n); ok {
+		r.add(name, sdk.ValAddress(bz).String())
+	}
+}
+
+func (r *keyReader) accAddress(name string, n int) {
+	if bz, ok := r.next(n); ok {
+		r.add(name, sdk.AccAddress(bz).String())
+	}
+}
+
+func (r *keyReader) string(name string, n int) {
+	if bz, ok := r.next(n); ok {
+		r.add(name, string(bz))
+	}
+}
+
+func (r *keyReader) hex(name string, n int) {
+	if bz, ok := r.next(n); ok {
+		r.add(name, hex.EncodeToString(bz))
+	}
+}
+
+// storeIndex reads the store index of an outgoing tx, see types.OutgoingTx.GetStoreIndex
+func (r *keyReader) storeIndex() {
+	bz, ok := r.next(1)
+	if !ok {
+		return
+	}
+
+	switch bz[0] {
+	case types.SignerSetTxPrefixByte:
+		r.add("type", "SignerSetTx")
+		r.uint64("signer_set_nonce")
+	case types.BatchTxPrefixByte:
+		r.add("type", "BatchTx")
+		r.ethAddress("token_contract")
+		r.uint64("batch_nonce")
+	case types.ContractCallTxPrefixByte:
+		r.add("type", "ContractCallTx")
+		r.hex("invalidation_scope", len(r.bz)-8)
+		r.uint64("invalidation_nonce")
+	default:
+		r.err = fmt.Errorf("invalid outgoing tx prefix %X", bz)
+	}
+}
@@ -0,0 +1,208 @@
+package decoder_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/decoder"
+	"github.com/cosmos/gravity-bridge/module/x/gravity/keeper"
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+func TestPrefixNames(t *testing.T) {
+	for prefix := types.ValidatorEthereumAddressKey; prefix <= types.BadSignatureEvidenceActivationKey; prefix++ {
+		name, ok := decoder.PrefixName(prefix)
+		require.True(t, ok, "prefix %X has no name", prefix)
+
+		parsed, err := decoder.ParsePrefix(name)
+		require.NoError(t, err)
+		require.Equal(t, prefix, parsed)
+	}
+
+	prefix, err := decoder.ParsePrefix("0x7")
+	require.NoError(t, err)
+	require.Equal(t, types.SendToEthereumKey, prefix)
+	prefix, err = decoder.ParsePrefix("sendtoethereum")
+	require.NoError(t, err)
+	require.Equal(t, types.SendToEthereumKey, prefix)
+
+	_, err = decoder.ParsePrefix("0xff")
+	require.Error(t, err)
+	_, err = decoder.ParsePrefix("Unknown")
+	require.Error(t, err)
+}
+
+func TestDecode(t *testing.T) {
+	cdc := keeper.MakeTestMarshaler()
+
+	valAddr := sdk.ValAddress([]byte("validator___________"))
+	orchAddr := sdk.AccAddress([]byte("orchestrator________"))
+	ethAddr := common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+	contract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+
+	send := types.SendToEthereum{
+		Id:                3,
+		Sender:            orchAddr.String(),
+		EthereumRecipient: ethAddr.Hex(),
+		Erc20Token:        types.NewSDKIntERC20Token(sdk.NewInt(100), contract),
+		Erc20Fee:          types.NewSDKIntERC20Token(sdk.NewInt(7), contract),
+	}
+	batch := &types.BatchTx{
+		BatchNonce:    5,
+		Timeout:       1000,
+		Transactions:  []*types.SendToEthereum{&send},
+		TokenContract: contract.Hex(),
+		Height:        10,
+	}
+	batchAny, err := types.PackOutgoingTx(batch)
+	require.NoError(t, err)
+	height := types.LatestEthereumBlockHeight{EthereumHeight: 100, CosmosHeight: 10}
+	escrow, err := sdk.NewInt(1000).Marshal()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		key    []byte
+		value  []byte
+		prefix string
+		fields []decoder.Field
+		json   string
+	}{
+		{
+			name:   "validator ethereum address",
+			key:    types.MakeValidatorEthereumAddressKey(valAddr),
+			value:  ethAddr.Bytes(),
+			prefix: "ValidatorEthereumAddress",
+			fields: []decoder.Field{{Name: "validator", Value: valAddr.String()}},
+			json:   `"` + ethAddr.Hex() + `"`,
+		},
+		{
+			name:   "orchestrator validator address",
+			key:    types.MakeOrchestratorValidatorAddressKey(orchAddr),
+			value:  valAddr.Bytes(),
+			prefix: "OrchestratorValidatorAddress",
+			fields: []decoder.Field{{Name: "orchestrator", Value: orchAddr.String()}},
+			json:   `"` + valAddr.String() + `"`,
+		},
+		{
+			name:   "ethereum signature",
+			key:    types.MakeEthereumSignatureKey(batch.GetStoreIndex(), valAddr),
+			value:  []byte{0xde, 0xad},
+			prefix: "EthereumSignature",
+			fields: []decoder.Field{
+				{Name: "type", Value: "BatchTx"},
+				{Name: "token_contract", Value: contract.Hex()},
+				{Name: "batch_nonce", Value: "5"},
+				{Name: "validator", Value: valAddr.String()},
+			},
+			json: `"dead"`,
+		},
+		{
+			name:   "outgoing tx",
+			key:    types.MakeOutgoingTxKey(types.MakeSignerSetTxKey(2)),
+			value:  cdc.MustMarshal(batchAny),
+			prefix: "OutgoingTx",
+			fields: []decoder.Field{{Name: "type", Value: "SignerSetTx"}, {Name: "signer_set_nonce", Value: "2"}},
+		},
+		{
+			name:   "send to ethereum",
+			key:    types.MakeSendToEthereumKey(send.Id, send.Erc20Fee),
+			value:  cdc.MustMarshal(&send),
+			prefix: "SendToEthereum",
+			fields: []decoder.Field{
+				{Name: "token_contract", Value: contract.Hex()},
+				{Name: "fee_amount", Value: "7"},
+				{Name: "id", Value: "3"},
+			},
+		},
+		{
+			name:   "last observed event nonce",
+			key:    []byte{types.LastObservedEventNonceKey},
+			value:  sdk.Uint64ToBigEndian(7),
+			prefix: "LastObservedEventNonce",
+			json:   `7`,
+		},
+		{
+			name:   "last ethereum block height",
+			key:    []byte{types.LastEthereumBlockHeightKey},
+			value:  cdc.MustMarshal(&height),
+			prefix: "LastEthereumBlockHeight",
+			json:   `{"cosmos_height":"10","ethereum_height":"100"}`,
+		},
+		{
+			name:   "missed signature",
+			key:    types.MakeMissedSignatureBitArrayKey(valAddr, 12),
+			value:  []byte{0x1},
+			prefix: "MissedSignatureBitArray",
+			fields: []decoder.Field{{Name: "validator", Value: valAddr.String()}, {Name: "index", Value: "12"}},
+			json:   `true`,
+		},
+		{
+			name:   "outflow bucket",
+			key:    types.MakeOutflowBucketKey("ugrav", 100),
+			value:  escrow,
+			prefix: "OutflowBucket",
+			fields: []decoder.Field{{Name: "denom", Value: "ugrav"}, {Name: "bucket_start_height", Value: "100"}},
+			json:   `"1000"`,
+		},
+		{
+			name:   "erc20 escrow",
+			key:    types.MakeERC20EscrowKey(contract),
+			value:  escrow,
+			prefix: "ERC20Escrow",
+			fields: []decoder.Field{{Name: "token_contract", Value: contract.Hex()}},
+			json:   `"1000"`,
+		},
+		{
+			name:   "send to ethereum expiry",
+			key:    types.MakeSendToEthereumExpiryKey(20, send.Id),
+			value:  cdc.MustMarshal(&send.Erc20Fee),
+			prefix: "SendToEthereumExpiry",
+			fields: []decoder.Field{{Name: "expires_at_height", Value: "20"}, {Name: "id", Value: "3"}},
+			json:   `{"contract":"` + contract.Hex() + `","amount":"7"}`,
+		},
+		{
+			name:   "bad signature evidence activation",
+			key:    []byte{types.BadSignatureEvidenceActivationKey},
+			value:  cdc.MustMarshal(&types.BadSignatureEvidenceActivation{Height: 10, SignerSetTxNonce: 2, BatchNonce: 3, EthereumHeight: 100}),
+			prefix: "BadSignatureEvidenceActivation",
+			json:   `{"batch_nonce":"3","ethereum_height":"100","height":"10","signer_set_tx_nonce":"2"}`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			entry, err := decoder.Decode(cdc, tt.key, tt.value)
+			require.NoError(t, err)
+			require.Equal(t, tt.prefix, entry.Prefix)
+			require.Equal(t, tt.fields, entry.Key)
+			if tt.json != "" {
+				require.JSONEq(t, tt.json, string(entry.Value))
+			}
+		})
+	}
+
+	t.Run("invalid keys", func(t *testing.T) {
+		_, err := decoder.Decode(cdc, []byte{0xFF}, []byte{0x1})
+		require.Error(t, err)
+		_, err = decoder.Decode(cdc, types.MakePendingSendToEthereumKey(1, 2)[:10], nil)
+		require.Error(t, err)
+		_, err = decoder.Decode(cdc, append(types.MakeERC20EscrowKey(contract), 0x1), escrow)
+		require.Error(t, err)
+	})
+}
+
+func TestDecodeStore(t *testing.T) {
+	dec := decoder.NewDecodeStore(keeper.MakeTestMarshaler())
+
+	kvA := kv.Pair{Key: []byte{types.LastSendToEthereumIDKey}, Value: sdk.Uint64ToBigEndian(1)}
+	kvB := kv.Pair{Key: []byte{types.LastSendToEthereumIDKey}, Value: sdk.Uint64ToBigEndian(2)}
+	require.Equal(t, "1\n2", dec(kvA, kvB))
+
+	invalid := kv.Pair{Key: []byte{0xFF}, Value: []byte{0x1}}
+	require.Panics(t, func() { dec(invalid, invalid) })
+}
@@ -11,17 +11,20 @@ import (
 	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
 )
 
-// TODO: should we make this a parameter or a a call arg?
-const BatchTxSize = 100
-
 // BuildBatchTx starts the following process chain:
 // - find bridged denominator for given voucher type
 // - determine if a an unexecuted batch is already waiting for this token type, if so confirm the new batch would
 //   have a higher total fees. If not exit withtout creating a batch
-// - select available transactions from the outgoing transaction pool sorted by fee desc
+// - select available transactions from the outgoing transaction pool sorted by fee desc, as many as fit in
+//   both maxElements and the max batch gas
 // - persist an outgoing batch object with an incrementing ID = nonce
 // - emit an event
 func (k Keeper) BuildBatchTx(ctx sdk.Context, contractAddress common.Address, maxElements int) *types.BatchTx {
+	params := k.GetParams(ctx)
+	if maxTransfers := params.MaxBatchTransfers(contractAddress); maxTransfers < maxElements {
+		maxElements = maxTransfers
+	}
+
 	// if there is a more profitable batch for this token type do not create a new batch
 	if lastBatch := k.getLastOutgoingBatchByTokenType(ctx, contractAddress); lastBatch != nil {
 		if lastBatch.GetFees().GTE(k.getBatchFeesByTokenType(ctx, contractAddress, maxElements)) {
@@ -32,7 +35,7 @@ func (k Keeper) BuildBatchTx(ctx sdk.Context, contractAddress common.Address, ma
 	var selectedStes []*types.SendToEthereum
 	k.iterateUnbatchedSendToEthereumsByContract(ctx, contractAddress, func(ste *types.SendToEthereum) bool {
 		selectedStes = append(selectedStes, ste)
-		k.deleteUnbatchedSendToEthereum(ctx, ste.Id, ste.Erc20Fee)
+		k.deleteUnbatchedSendToEthereum(ctx, ste)
 		return len(selectedStes) == maxElements
 	})
 
@@ -42,6 +45,7 @@ func (k Keeper) BuildBatchTx(ctx sdk.Context, contractAddress common.Address, ma
 		Transactions:  selectedStes,
 		TokenContract: contractAddress.Hex(),
 		Height:        uint64(ctx.BlockHeight()),
+		EstimatedGas:  params.BatchTxGas(contractAddress, len(selectedStes)),
 	}
 	k.SetOutgoingTx(ctx, batch)
 
@@ -82,14 +86,33 @@ func (k Keeper) getBatchTimeoutHeight(ctx sdk.Context) uint64 {
 func (k Keeper) batchTxExecuted(ctx sdk.Context, tokenContract common.Address, nonce uint64) {
 	otx := k.GetOutgoingTx(ctx, types.MakeBatchTxKey(tokenContract, nonce))
 	batchTx, _ := otx.(*types.BatchTx)
+	if batchTx == nil {
+		// the batch was already canceled, e.g. it timed out, there is nothing left to settle
+		k.Logger(ctx).Error("executed batch tx not found", "token_contract", tokenContract.Hex(), "nonce", nonce)
+		return
+	}
+
+	// If the iterated batches of the token have a lower nonce than the one that was just executed, cancel them
+	var earlierNonces []uint64
 	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
-		// If the iterated batches nonce is lower than the one that was just executed, cancel it
 		btx, _ := otx.(*types.BatchTx)
-		if (btx.BatchNonce < batchTx.BatchNonce) && (batchTx.TokenContract == tokenContract.Hex()) {
-			k.CancelBatchTx(ctx, tokenContract, btx.BatchNonce)
+		if btx.BatchNonce < batchTx.BatchNonce && common.HexToAddress(btx.TokenContract) == tokenContract {
+			earlierNonces = append(earlierNonces, btx.BatchNonce)
 		}
 		return false
 	})
+	for _, earlierNonce := range earlierNonces {
+		k.CancelBatchTx(ctx, tokenContract, earlierNonce)
+	}
+
+	// the vouchers of the batch were burned when it was built, the tokens have now left the bridge contract
+	if isCosmosOriginated, _ := k.ERC20ToDenomLookup(ctx, batchTx.TokenContract); !isCosmosOriginated {
+		withdrawn := sdk.ZeroInt()
+		for _, tx := range batchTx.Transactions {
+			withdrawn = withdrawn.Add(tx.Erc20Token.Amount).Add(tx.Erc20Fee.Amount)
+		}
+		k.addERC20Escrow(ctx, tokenContract, withdrawn.Neg())
+	}
 	k.DeleteOutgoingTx(ctx, batchTx.GetStoreIndex())
 }
 
@@ -124,7 +147,7 @@ func (k Keeper) GetBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr commo
 	return feeAmount
 }
 
-// CancelBatchTx releases all TX in the batch and deletes the batch
+// CancelBatchTx releases all TX in the batch, refunding the expired ones, and deletes the batch
 func (k Keeper) CancelBatchTx(ctx sdk.Context, tokenContract common.Address, nonce uint64) {
 	otx := k.GetOutgoingTx(ctx, types.MakeBatchTxKey(tokenContract, nonce))
 	batch, _ := otx.(*types.BatchTx)
@@ -132,6 +155,9 @@ func (k Keeper) CancelBatchTx(ctx sdk.Context, tokenContract common.Address, non
 	// free transactions from batch and reindex them
 	for _, tx := range batch.Transactions {
 		k.setUnbatchedSendToEthereum(ctx, tx)
+		if isExpired(ctx, tx) {
+			k.refundExpiredSendToEthereum(ctx, tx)
+		}
 	}
 
 	// Delete batch since it is finished
@@ -178,9 +204,14 @@ func (k Keeper) GetLastSlashedOutgoingTxBlockHeight(ctx sdk.Context) uint64 {
 	}
 }
 
+// GetUnSlashedOutgoingTxs returns the signer set and batch txs created before maxHeight that have not
+// yet been checked for slashing. Contract call txs have their own window, see GetUnSlashedContractCallTxs
 func (k Keeper) GetUnSlashedOutgoingTxs(ctx sdk.Context, maxHeight uint64) (out []types.OutgoingTx) {
 	lastSlashed := k.GetLastSlashedOutgoingTxBlockHeight(ctx)
 	k.iterateOutgoingTxs(ctx, func(key []byte, otx types.OutgoingTx) bool {
+		if _, ok := otx.(*types.ContractCallTx); ok {
+			return false
+		}
 		if (otx.GetCosmosHeight() < maxHeight) && (otx.GetCosmosHeight() > lastSlashed) {
 			out = append(out, otx)
 		}
@@ -189,15 +220,47 @@ func (k Keeper) GetUnSlashedOutgoingTxs(ctx sdk.Context, maxHeight uint64) (out
 	return
 }
 
-func (k Keeper) incrementLastOutgoingBatchNonce(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get([]byte{types.LastOutgoingBatchNonceKey})
-	var id uint64 = 0
-	if bz != nil {
-		id = binary.BigEndian.Uint64(bz)
+// SetLastSlashedContractCallTxBlockHeight sets the latest slashed contract call tx block height
+func (k Keeper) SetLastSlashedContractCallTxBlockHeight(ctx sdk.Context, blockHeight uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LastSlashedContractCallTxBlockKey}, sdk.Uint64ToBigEndian(blockHeight))
+}
+
+// GetLastSlashedContractCallTxBlockHeight returns the latest slashed contract call tx block height
+func (k Keeper) GetLastSlashedContractCallTxBlockHeight(ctx sdk.Context) uint64 {
+	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastSlashedContractCallTxBlockKey}); bz == nil {
+		return 0
+	} else {
+		return binary.BigEndian.Uint64(bz)
 	}
-	newId := id + 1
-	bz = sdk.Uint64ToBigEndian(newId)
-	store.Set([]byte{types.LastOutgoingBatchNonceKey}, bz)
+}
+
+// GetUnSlashedContractCallTxs returns the contract call txs created before maxHeight that have not
+// yet been checked for slashing
+func (k Keeper) GetUnSlashedContractCallTxs(ctx sdk.Context, maxHeight uint64) (out []*types.ContractCallTx) {
+	lastSlashed := k.GetLastSlashedContractCallTxBlockHeight(ctx)
+	k.IterateOutgoingTxsByType(ctx, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		cctx, _ := otx.(*types.ContractCallTx)
+		if (cctx.Height < maxHeight) && (cctx.Height > lastSlashed) {
+			out = append(out, cctx)
+		}
+		return false
+	})
+	return
+}
+
+func (k Keeper) incrementLastOutgoingBatchNonce(ctx sdk.Context) uint64 {
+	newId := k.getLastOutgoingBatchNonce(ctx) + 1
+	k.setLastOutgoingBatchNonce(ctx, newId)
 	return newId
 }
+
+func (k Keeper) getLastOutgoingBatchNonce(ctx sdk.Context) uint64 {
+	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastOutgoingBatchNonceKey}); bz != nil {
+		return binary.BigEndian.Uint64(bz)
+	}
+	return 0
+}
+
+func (k Keeper) setLastOutgoingBatchNonce(ctx sdk.Context, nonce uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LastOutgoingBatchNonceKey}, sdk.Uint64ToBigEndian(nonce))
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// BatchCreationPolicy decides when the BeginBlocker creates a batch tx from the unbatched sends to ethereum of a
+// token contract, and how many of them it takes. Chains can replace the default policy, which is driven by the
+// batch params, by setting the BatchCreationPolicy of the keeper before it is passed to the other modules.
+type BatchCreationPolicy interface {
+	// MaxBatchSize returns the max number of sends to ethereum in a batch tx of the token contract
+	MaxBatchSize(ctx sdk.Context, tokenContract common.Address) int
+	// ShouldCreateBatchTx returns whether a batch tx of the token contract should be created in this block
+	ShouldCreateBatchTx(ctx sdk.Context, tokenContract common.Address) bool
+}
+
+// ParamsBatchCreationPolicy creates a batch tx of a token every BatchCreationInterval blocks if its best paying
+// unbatched sends pay the MinBatchFees of the token, and if it has fewer than MaxOutstandingBatches batch txs
+// waiting to be executed
+type ParamsBatchCreationPolicy struct {
+	keeper Keeper
+}
+
+var _ BatchCreationPolicy = ParamsBatchCreationPolicy{}
+
+// NewParamsBatchCreationPolicy returns the default batch creation policy of the keeper
+func NewParamsBatchCreationPolicy(k Keeper) ParamsBatchCreationPolicy {
+	return ParamsBatchCreationPolicy{keeper: k}
+}
+
+// MaxBatchSize implements BatchCreationPolicy, the size is capped by the number of transfers of the token that
+// fit in the max batch gas
+func (p ParamsBatchCreationPolicy) MaxBatchSize(ctx sdk.Context, tokenContract common.Address) int {
+	params := p.keeper.GetParams(ctx)
+	if maxTransfers := params.MaxBatchTransfers(tokenContract); uint64(maxTransfers) < params.MaxBatchSize {
+		return maxTransfers
+	}
+	return int(params.MaxBatchSize)
+}
+
+// ShouldCreateBatchTx implements BatchCreationPolicy
+func (p ParamsBatchCreationPolicy) ShouldCreateBatchTx(ctx sdk.Context, tokenContract common.Address) bool {
+	params := p.keeper.GetParams(ctx)
+	if uint64(ctx.BlockHeight())%params.BatchCreationInterval != 0 {
+		return false
+	}
+	if params.MaxOutstandingBatches != 0 && p.keeper.countBatchTxs(ctx, tokenContract) >= params.MaxOutstandingBatches {
+		return false
+	}
+	fees := p.keeper.GetBatchFeesByTokenType(ctx, tokenContract, p.MaxBatchSize(ctx, tokenContract))
+	return fees.GTE(params.MinBatchFeeOf(tokenContract))
+}
+
+// countBatchTxs returns the number of batch txs of the token contract waiting to be executed
+func (k Keeper) countBatchTxs(ctx sdk.Context, tokenContract common.Address) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeOutgoingTxKey(append([]byte{types.BatchTxPrefixByte}, tokenContract.Bytes()...)))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	count := uint64(0)
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count
+}
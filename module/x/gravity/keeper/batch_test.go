@@ -56,6 +56,7 @@ func TestBatches(t *testing.T) {
 		},
 		TokenContract: myTokenContractAddr.Hex(),
 		Height:        1234567,
+		EstimatedGas:  590000,
 	}
 
 	assert.Equal(t, expFirstBatch.Transactions, gfb.Transactions)
@@ -92,6 +93,7 @@ func TestBatches(t *testing.T) {
 		},
 		TokenContract: myTokenContractAddr.Hex(),
 		Height:        1234567,
+		EstimatedGas:  590000,
 	}
 
 	assert.Equal(t, expSecondBatch, secondBatch)
@@ -152,7 +154,7 @@ func TestBatchesFullCoins(t *testing.T) {
 		vAsSDKInt := sdk.NewIntFromUint64(v)
 		amount := types.NewSDKIntERC20Token(oneEth.Mul(vAsSDKInt), myTokenContractAddr).GravityCoin()
 		fee := types.NewSDKIntERC20Token(oneEth.Mul(vAsSDKInt), myTokenContractAddr).GravityCoin()
-		_, err := input.GravityKeeper.createSendToEthereum(ctx, mySender, myReceiver.Hex(), amount, fee)
+		_, err := input.GravityKeeper.createSendToEthereum(ctx, mySender, myReceiver.Hex(), amount, fee, 0)
 		require.NoError(t, err)
 	}
 
@@ -186,6 +188,7 @@ func TestBatchesFullCoins(t *testing.T) {
 		},
 		TokenContract: myTokenContractAddr.Hex(),
 		Height:        1234567,
+		EstimatedGas:  590000,
 	}
 	assert.Equal(t, expFirstBatch, gotFirstBatch)
 
@@ -221,7 +224,7 @@ func TestBatchesFullCoins(t *testing.T) {
 		vAsSDKInt := sdk.NewIntFromUint64(v)
 		amount := types.NewSDKIntERC20Token(oneEth.Mul(vAsSDKInt), myTokenContractAddr).GravityCoin()
 		fee := types.NewSDKIntERC20Token(oneEth.Mul(vAsSDKInt), myTokenContractAddr).GravityCoin()
-		_, err := input.GravityKeeper.createSendToEthereum(ctx, mySender, myReceiver.Hex(), amount, fee)
+		_, err := input.GravityKeeper.createSendToEthereum(ctx, mySender, myReceiver.Hex(), amount, fee, 0)
 		require.NoError(t, err)
 	}
 
@@ -251,6 +254,7 @@ func TestBatchesFullCoins(t *testing.T) {
 		},
 		TokenContract: myTokenContractAddr.Hex(),
 		Height:        1234567,
+		EstimatedGas:  590000,
 	}
 
 	assert.Equal(t, expSecondBatch, secondBatch)
@@ -304,6 +308,115 @@ func TestBatchesFullCoins(t *testing.T) {
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
 }
 
+func TestBuildBatchTxGasBudget(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		allVouchers         = sdk.NewCoins(
+			types.NewERC20Token(99999, myTokenContractAddr.Hex()).GravityCoin(),
+		)
+	)
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 1, 2, 3, 4, 5, 6)
+
+	// room for three transfers of 100 gas
+	params := input.GravityKeeper.GetParams(ctx)
+	params.MaxBatchGas = 1399
+	params.BatchBaseGas = 1000
+	params.BatchTransferGas = 100
+	input.GravityKeeper.SetParams(ctx, params)
+
+	// every batch is built from the same pool
+	buildBatchTx := func(maxElements int) *types.BatchTx {
+		cacheCtx, _ := ctx.CacheContext()
+		return input.GravityKeeper.BuildBatchTx(cacheCtx, myTokenContractAddr, maxElements)
+	}
+
+	batch := buildBatchTx(10)
+	require.Len(t, batch.Transactions, 3)
+	require.Equal(t, uint64(1300), batch.EstimatedGas)
+
+	// the token has an expensive transfer hook
+	params.TokenTransferGas = []types.TokenTransferGas{{TokenContract: myTokenContractAddr.Hex(), TransferGas: 300}}
+	input.GravityKeeper.SetParams(ctx, params)
+
+	batch = buildBatchTx(10)
+	require.Len(t, batch.Transactions, 1)
+	require.Equal(t, uint64(1300), batch.EstimatedGas)
+	require.Equal(t, 1, input.GravityKeeper.BatchCreationPolicy.MaxBatchSize(ctx, myTokenContractAddr))
+
+	// the batch is smaller than its gas budget allows
+	params.TokenTransferGas = nil
+	input.GravityKeeper.SetParams(ctx, params)
+
+	batch = buildBatchTx(1)
+	require.Len(t, batch.Transactions, 1)
+	require.Equal(t, uint64(1100), batch.EstimatedGas)
+}
+
+func TestBatchTxExecutedKeepsOtherTokens(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _ = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver  = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		contractA   = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		contractB   = common.HexToAddress("0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e")
+	)
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, sdk.NewCoins(
+		types.NewERC20Token(99999, contractA.Hex()).GravityCoin(),
+		types.NewERC20Token(99999, contractB.Hex()).GravityCoin(),
+	)))
+	input.AddSendToEthTxsToPool(t, ctx, contractA, mySender, myReceiver, 1)
+	batchA1 := input.GravityKeeper.BuildBatchTx(ctx, contractA, 10)
+	input.AddSendToEthTxsToPool(t, ctx, contractB, mySender, myReceiver, 1)
+	batchB := input.GravityKeeper.BuildBatchTx(ctx, contractB, 10)
+	input.AddSendToEthTxsToPool(t, ctx, contractA, mySender, myReceiver, 2)
+	batchA2 := input.GravityKeeper.BuildBatchTx(ctx, contractA, 10)
+
+	input.GravityKeeper.batchTxExecuted(ctx, contractA, batchA2.BatchNonce)
+
+	// the earlier batch of the token is canceled, the batch of the other token is left alone
+	require.Nil(t, input.GravityKeeper.GetOutgoingTx(ctx, batchA1.GetStoreIndex()))
+	require.Nil(t, input.GravityKeeper.GetOutgoingTx(ctx, batchA2.GetStoreIndex()))
+	require.NotNil(t, input.GravityKeeper.GetOutgoingTx(ctx, batchB.GetStoreIndex()))
+}
+
+func TestBatchTxExecutedCanceledBatch(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, sdk.NewCoins(
+		types.NewERC20Token(99999, myTokenContractAddr.Hex()).GravityCoin(),
+	)))
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 1, 2)
+	batch := input.GravityKeeper.BuildBatchTx(ctx, myTokenContractAddr, 10)
+
+	// the batch is gone by the time its execution is observed
+	input.GravityKeeper.CancelBatchTx(ctx, myTokenContractAddr, batch.BatchNonce)
+	require.NotPanics(t, func() {
+		input.GravityKeeper.batchTxExecuted(ctx, myTokenContractAddr, batch.BatchNonce)
+	})
+
+	// the sends of the batch are back in the pool
+	var unbatched []*types.SendToEthereum
+	input.GravityKeeper.IterateUnbatchedSendToEthereums(ctx, func(tx *types.SendToEthereum) bool {
+		unbatched = append(unbatched, tx)
+		return false
+	})
+	require.Len(t, unbatched, 2)
+}
+
 func TestPoolTxRefund(t *testing.T) {
 	input := CreateTestEnv(t)
 	ctx := input.Context
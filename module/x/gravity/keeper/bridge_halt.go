@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// IsBridgeHalted returns true if an executed signer set on Ethereum did not match the one produced by
+// the chain and the bridge hasn't been resumed by governance since
+func (k Keeper) IsBridgeHalted(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has([]byte{types.BridgeHaltedKey})
+}
+
+// getBridgeHaltedSignerSetNonce returns the nonce of the executed signer set that halted the bridge
+func (k Keeper) getBridgeHaltedSignerSetNonce(ctx sdk.Context) (uint64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.BridgeHaltedKey})
+	if bz == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(bz), true
+}
+
+func (k Keeper) setBridgeHalted(ctx sdk.Context, signerSetNonce uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.BridgeHaltedKey}, sdk.Uint64ToBigEndian(signerSetNonce))
+}
+
+// haltBridge stops sends to ethereum, batch creation and ethereum event processing until governance
+// resumes the bridge
+func (k Keeper) haltBridge(ctx sdk.Context, signerSetNonce uint64, expected, observed []byte) {
+	k.setBridgeHalted(ctx, signerSetNonce)
+
+	k.Logger(ctx).Error(
+		"executed signer set does not match the stored signer set, halting the bridge",
+		"signer set nonce", signerSetNonce,
+		"expected", hex.EncodeToString(expected),
+		"observed", hex.EncodeToString(observed),
+	)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBridgeHalted,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+		sdk.NewAttribute(types.AttributeKeySignerSetNonce, fmt.Sprint(signerSetNonce)),
+		sdk.NewAttribute(types.AttributeKeyExpectedSignerSetHash, hex.EncodeToString(expected)),
+		sdk.NewAttribute(types.AttributeKeyObservedSignerSetHash, hex.EncodeToString(observed)),
+	))
+}
+
+// ResumeBridge clears the bridge halt and processes the held deposits of the tokens that aren't paused
+func (k Keeper) ResumeBridge(ctx sdk.Context) error {
+	if !k.IsBridgeHalted(ctx) {
+		return sdkerrors.Wrap(types.ErrInvalid, "bridge is not halted")
+	}
+
+	ctx.KVStore(k.storeKey).Delete([]byte{types.BridgeHaltedKey})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBridgeResumed,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+	))
+
+	k.releaseParkedSendToCosmosEvents(ctx)
+	return nil
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// HandleBridgePauseProposal pauses or resumes the bridge for the tokens of the proposal, or for every token
+// if the proposal is global
+func (k Keeper) HandleBridgePauseProposal(ctx sdk.Context, p *types.BridgePauseProposal) error {
+	// resolve every token first so the proposal is applied entirely or not at all
+	contracts := make([]common.Address, 0, len(p.Tokens))
+	for _, token := range p.Tokens {
+		contract, err := k.resolveTokenContract(ctx, token)
+		if err != nil {
+			return err
+		}
+		contracts = append(contracts, contract)
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyPaused, fmt.Sprint(p.Paused)),
+	}
+
+	if p.Global {
+		k.setBridgePaused(ctx, p.Paused)
+	}
+	for _, contract := range contracts {
+		k.setTokenPaused(ctx, contract, p.Paused)
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyTokenContract, contract.Hex()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeBridgePause, attributes...))
+
+	if !p.Paused {
+		k.releaseParkedSendToCosmosEvents(ctx)
+	}
+	return nil
+}
+
+// resolveTokenContract returns the ERC20 contract of a token given as a contract address or a denom
+func (k Keeper) resolveTokenContract(ctx sdk.Context, token string) (common.Address, error) {
+	if common.IsHexAddress(token) {
+		return common.HexToAddress(token), nil
+	}
+
+	_, contract, err := k.DenomToERC20Lookup(ctx, token)
+	return contract, err
+}
+
+// IsBridgePaused returns true if the bridge is paused for every token
+func (k Keeper) IsBridgePaused(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has([]byte{types.BridgePausedKey})
+}
+
+// IsTokenPaused returns true if the bridge is paused for the ERC20 contract, either globally or for the token
+func (k Keeper) IsTokenPaused(ctx sdk.Context, contract common.Address) bool {
+	return k.IsBridgePaused(ctx) || ctx.KVStore(k.storeKey).Has(types.MakePausedTokenKey(contract))
+}
+
+// GetPausedTokenContracts returns the ERC20 contracts the bridge is paused for individually
+func (k Keeper) GetPausedTokenContracts(ctx sdk.Context) (out []common.Address) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.PausedTokenKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		out = append(out, common.BytesToAddress(iter.Key()))
+	}
+	return out
+}
+
+func (k Keeper) setBridgePaused(ctx sdk.Context, paused bool) {
+	if paused {
+		ctx.KVStore(k.storeKey).Set([]byte{types.BridgePausedKey}, []byte{0x1})
+	} else {
+		ctx.KVStore(k.storeKey).Delete([]byte{types.BridgePausedKey})
+	}
+}
+
+func (k Keeper) setTokenPaused(ctx sdk.Context, contract common.Address, paused bool) {
+	if paused {
+		ctx.KVStore(k.storeKey).Set(types.MakePausedTokenKey(contract), []byte{0x1})
+	} else {
+		ctx.KVStore(k.storeKey).Delete(types.MakePausedTokenKey(contract))
+	}
+}
+
+// parkSendToCosmosEvent holds the deposit of a paused token until the token is resumed
+func (k Keeper) parkSendToCosmosEvent(ctx sdk.Context, event *types.SendToCosmosEvent) {
+	k.setParkedSendToCosmosEvent(ctx, event)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSendToCosmosParked,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyTokenContract, event.TokenContract),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.EventNonce)),
+	))
+}
+
+func (k Keeper) setParkedSendToCosmosEvent(ctx sdk.Context, event *types.SendToCosmosEvent) {
+	ctx.KVStore(k.storeKey).Set(types.MakeParkedSendToCosmosEventKey(event.EventNonce), k.cdc.MustMarshal(event))
+}
+
+// IterateParkedSendToCosmosEvents iterates through the held deposits of paused tokens in event nonce order
+func (k Keeper) IterateParkedSendToCosmosEvents(ctx sdk.Context, cb func(*types.SendToCosmosEvent) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ParkedSendToCosmosEventKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var event types.SendToCosmosEvent
+		k.cdc.MustUnmarshal(iter.Value(), &event)
+		if cb(&event) {
+			break
+		}
+	}
+}
+
+// releaseParkedSendToCosmosEvents processes the held deposits of the tokens that are no longer paused. It runs
+// when tokens are resumed, and when the bridge halt is lifted since no deposit is processed while it is halted.
+func (k Keeper) releaseParkedSendToCosmosEvents(ctx sdk.Context) {
+	if k.IsBridgeHalted(ctx) {
+		return
+	}
+
+	var resumed []*types.SendToCosmosEvent
+	k.IterateParkedSendToCosmosEvents(ctx, func(event *types.SendToCosmosEvent) bool {
+		if !k.IsTokenPaused(ctx, common.HexToAddress(event.TokenContract)) {
+			resumed = append(resumed, event)
+		}
+		return false
+	})
+
+	for _, event := range resumed {
+		ctx.KVStore(k.storeKey).Delete(types.MakeParkedSendToCosmosEventKey(event.EventNonce))
+		k.processEthereumEvent(ctx, event)
+	}
+}
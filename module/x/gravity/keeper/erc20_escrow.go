@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// GetERC20Escrow returns the amount of an ethereum originated ERC20 the bridge contract holds for the
+// vouchers on this chain and the sends to ethereum that weren't executed yet
+func (k Keeper) GetERC20Escrow(ctx sdk.Context, contract common.Address) sdk.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeERC20EscrowKey(contract))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+// addERC20Escrow adds the amount, which is negative for withdrawals, to the escrow of the ERC20
+func (k Keeper) addERC20Escrow(ctx sdk.Context, contract common.Address, amount sdk.Int) {
+	k.setERC20Escrow(ctx, types.NewSDKIntERC20Token(k.GetERC20Escrow(ctx, contract).Add(amount), contract))
+}
+
+func (k Keeper) setERC20Escrow(ctx sdk.Context, escrow types.ERC20Token) {
+	key := types.MakeERC20EscrowKey(common.HexToAddress(escrow.Contract))
+	if escrow.Amount.IsZero() {
+		ctx.KVStore(k.storeKey).Delete(key)
+		return
+	}
+
+	bz, err := escrow.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(key, bz)
+}
+
+// IterateERC20Escrows iterates through the escrows of the ethereum originated ERC20s
+func (k Keeper) IterateERC20Escrows(ctx sdk.Context, cb func(types.ERC20Token) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ERC20EscrowKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+		if cb(types.NewSDKIntERC20Token(amount, common.BytesToAddress(iter.Key()))) {
+			break
+		}
+	}
+}
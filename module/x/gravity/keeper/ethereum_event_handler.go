@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -31,6 +32,12 @@ func (a EthereumEventProcessor) DetectMaliciousSupply(ctx sdk.Context, denom str
 func (a EthereumEventProcessor) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 	switch event := eve.(type) {
 	case *types.SendToCosmosEvent:
+		// Deposits of paused tokens are held until the token is resumed
+		if a.keeper.IsTokenPaused(ctx, common.HexToAddress(event.TokenContract)) {
+			a.keeper.parkSendToCosmosEvent(ctx, event)
+			return nil
+		}
+
 		// Check if coin is Cosmos-originated asset and get denom
 		isCosmosOriginated, denom := a.keeper.ERC20ToDenomLookup(ctx, event.TokenContract)
 		addr, _ := sdk.AccAddressFromBech32(event.CosmosReceiver)
@@ -45,6 +52,9 @@ func (a EthereumEventProcessor) Handle(ctx sdk.Context, eve types.EthereumEvent)
 			if err := a.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
 				return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
 			}
+
+			// the deposited tokens are held by the bridge contract for the vouchers
+			a.keeper.addERC20Escrow(ctx, common.HexToAddress(event.TokenContract), event.Amount)
 		}
 
 		if err := a.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
@@ -73,9 +83,11 @@ func (a EthereumEventProcessor) Handle(ctx sdk.Context, eve types.EthereumEvent)
 		return nil
 
 	case *types.SignerSetTxExecutedEvent:
-		// TODO here we should check the contents of the validator set against
-		// the store, if they differ we should take some action to indicate to the
-		// user that bridge highjacking has occurred
+		if !a.verifySignerSetTxExecutedEvent(ctx, event) {
+			// the bridge has been hijacked, the halt is the outcome of this event
+			return nil
+		}
+
 		a.keeper.setLastObservedSignerSetTx(ctx, types.SignerSetTx{
 			Nonce:   event.SignerSetTxNonce,
 			Signers: event.Members,
@@ -88,6 +100,30 @@ func (a EthereumEventProcessor) Handle(ctx sdk.Context, eve types.EthereumEvent)
 	}
 }
 
+// verifySignerSetTxExecutedEvent checks the executed signer set against the signer set tx the chain produced
+// with the same nonce, and halts the bridge if they differ
+func (a EthereumEventProcessor) verifySignerSetTxExecutedEvent(ctx sdk.Context, event *types.SignerSetTxExecutedEvent) bool {
+	// the contract is deployed with a signer set that was never produced by the chain
+	if event.SignerSetTxNonce == 0 {
+		return true
+	}
+
+	// hashing sorts the signers, so hash a copy to leave the members of the event in their order
+	observed := append(types.EthereumSigners{}, event.Members...).Hash()
+
+	var expected []byte
+	if signerSet, ok := a.keeper.GetOutgoingTx(ctx, types.MakeSignerSetTxKey(event.SignerSetTxNonce)).(*types.SignerSetTx); ok {
+		expected = types.EthereumSigners(signerSet.Signers).Hash()
+	}
+
+	if !bytes.Equal(expected, observed) {
+		a.keeper.haltBridge(ctx, event.SignerSetTxNonce, expected, observed)
+		return false
+	}
+
+	return true
+}
+
 func (a EthereumEventProcessor) verifyERC20DeployedEvent(ctx sdk.Context, event *types.ERC20DeployedEvent) error {
 	if existingERC20, exists := a.keeper.getCosmosOriginatedERC20(ctx, event.CosmosDenom); exists {
 		return sdkerrors.Wrapf(
@@ -1,10 +1,13 @@
 package keeper
 
 import (
-	sdktypes "github.com/cosmos/cosmos-sdk/types"
-	"github.com/stretchr/testify/require"
 	"math/big"
 	"testing"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
 )
 
 func TestEthereumEventProcessor_DetectMaliciousSupply(t *testing.T) {
@@ -18,4 +21,84 @@ func TestEthereumEventProcessor_DetectMaliciousSupply(t *testing.T) {
 
 	err := eep.DetectMaliciousSupply(input.Context, "stake", bigCoinAmount)
 	require.Error(t, err, "didn't error out on too much added supply")
-}
\ No newline at end of file
+}
+
+func TestEthereumEventProcessor_SignerSetTxExecutedEventKeepsMemberOrder(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	eep := EthereumEventProcessor{keeper: k, bankKeeper: input.BankKeeper}
+
+	signerSet := k.CreateSignerSetTx(ctx)
+	require.Greater(t, len(signerSet.Signers), 1)
+
+	// the same signers in the reverse order are the same signer set
+	var members types.EthereumSigners
+	for i := len(signerSet.Signers) - 1; i >= 0; i-- {
+		members = append(members, signerSet.Signers[i])
+	}
+	event := &types.SignerSetTxExecutedEvent{
+		EventNonce:       1,
+		SignerSetTxNonce: signerSet.Nonce,
+		EthereumHeight:   1,
+		Members:          append(types.EthereumSigners{}, members...),
+	}
+	require.NoError(t, eep.Handle(ctx, event))
+	require.False(t, k.IsBridgeHalted(ctx))
+	require.Equal(t, []*types.EthereumSigner(members), event.Members)
+}
+
+func TestEthereumEventProcessor_SignerSetTxExecutedEvent(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	eep := EthereumEventProcessor{keeper: k, bankKeeper: input.BankKeeper}
+
+	signerSet := k.CreateSignerSetTx(ctx)
+
+	// an executed signer set matching the stored one is observed
+	require.NoError(t, eep.Handle(ctx, &types.SignerSetTxExecutedEvent{
+		EventNonce:       1,
+		SignerSetTxNonce: signerSet.Nonce,
+		EthereumHeight:   1,
+		Members:          signerSet.Signers,
+	}))
+	require.False(t, k.IsBridgeHalted(ctx))
+	require.Equal(t, signerSet.Nonce, k.GetLastObservedSignerSetTx(ctx).Nonce)
+
+	// an executed signer set that the chain never produced halts the bridge
+	hijacked := types.EthereumSigners{{Power: 4294967295, EthereumAddress: "0x0000000000000000000000000000000000000001"}}
+	require.NoError(t, eep.Handle(ctx, &types.SignerSetTxExecutedEvent{
+		EventNonce:       2,
+		SignerSetTxNonce: signerSet.Nonce + 1,
+		EthereumHeight:   2,
+		Members:          hijacked,
+	}))
+	require.True(t, k.IsBridgeHalted(ctx))
+	require.Equal(t, signerSet.Nonce, k.GetLastObservedSignerSetTx(ctx).Nonce)
+
+	var halted bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeBridgeHalted {
+			halted = true
+		}
+	}
+	require.True(t, halted)
+
+	// sends to ethereum and batch requests are refused while the bridge is halted
+	msgServer := NewMsgServerImpl(k)
+	_, err := msgServer.SendToEthereum(sdktypes.WrapSDKContext(ctx), &types.MsgSendToEthereum{
+		Sender:            AccAddrs[0].String(),
+		EthereumRecipient: EthAddrs[1].String(),
+		Amount:            types.NewERC20Token(100, TokenContractAddrs[0]).GravityCoin(),
+		BridgeFee:         types.NewERC20Token(1, TokenContractAddrs[0]).GravityCoin(),
+	})
+	require.ErrorIs(t, err, types.ErrBridgeHalted)
+	_, err = msgServer.RequestBatchTx(sdktypes.WrapSDKContext(ctx), &types.MsgRequestBatchTx{
+		Denom:  types.NewERC20Token(1, TokenContractAddrs[0]).GravityCoin().Denom,
+		Signer: AccAddrs[0].String(),
+	})
+	require.ErrorIs(t, err, types.ErrBridgeHalted)
+
+	require.NoError(t, k.ResumeBridge(ctx))
+	require.False(t, k.IsBridgeHalted(ctx))
+	require.Error(t, k.ResumeBridge(ctx))
+}
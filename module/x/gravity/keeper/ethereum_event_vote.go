@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"strconv"
@@ -8,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
 )
@@ -41,18 +43,35 @@ func (k Keeper) recordEventVote(
 		if err != nil {
 			return nil, err
 		}
+		// the record is tallied against the power of the validator set at its creation, so power changes
+		// after the votes are cast don't change whether it passes
 		eventVoteRecord = &types.EthereumEventVoteRecord{
-			Accepted: false,
-			Event:    any,
+			Accepted:       false,
+			Event:          any,
+			VotePower:      sdk.ZeroInt(),
+			TotalPower:     k.StakingKeeper.GetLastTotalPower(ctx),
+			CreationHeight: uint64(ctx.BlockHeight()),
 		}
+	} else {
+		k.fillEventVoteRecordPowers(ctx, eventVoteRecord)
 	}
 
-	// Add the validator's vote to this EthereumEventVoteRecord
-	eventVoteRecord.Votes = append(eventVoteRecord.Votes, val.String())
+	// Add the validator's vote with its current power to this EthereumEventVoteRecord
+	power := k.StakingKeeper.GetLastValidatorPower(ctx, val)
+	eventVoteRecord.Votes = append(eventVoteRecord.Votes, types.EthereumEventVote{Validator: val.String(), Power: power})
+	eventVoteRecord.VotePower = eventVoteRecord.VotePower.Add(sdk.NewInt(power))
 
 	k.setEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash(), eventVoteRecord)
 	k.setLastEventNonceByValidator(ctx, val, event.GetEventNonce())
 
+	// a vote for a different event than the one already accepted at the nonce is slashed right away, like
+	// the conflicting votes cast before the event was accepted
+	if event.GetEventNonce() <= k.GetLastObservedEventNonce(ctx) {
+		if summary := k.GetEthereumEventSummary(ctx, event.GetEventNonce()); summary != nil && !bytes.Equal(summary.EventHash, event.Hash()) {
+			k.slashConflictingEventVote(ctx, event, val)
+		}
+	}
+
 	return eventVoteRecord, nil
 }
 
@@ -67,46 +86,36 @@ func (k Keeper) TryEventVoteRecord(ctx sdk.Context, eventVoteRecord *types.Ether
 		if err := k.cdc.UnpackAny(eventVoteRecord.Event, &event); err != nil {
 			panic("unpacking packed any")
 		}
+		k.fillEventVoteRecordPowers(ctx, eventVoteRecord)
 
-		// Sum the current powers of all validators who have voted and see if it passes the current threshold
-		// TODO: The different integer types and math here needs a careful review
-		requiredPower := types.EventVoteRecordPowerThreshold(k.StakingKeeper.GetLastTotalPower(ctx))
-		eventVotePower := sdk.NewInt(0)
-		for _, validator := range eventVoteRecord.Votes {
-			val, _ := sdk.ValAddressFromBech32(validator)
-
-			validatorPower := k.StakingKeeper.GetLastValidatorPower(ctx, val)
-			// Add it to the attestation power's sum
-			eventVotePower = eventVotePower.Add(sdk.NewInt(validatorPower))
-			// If the power of all the validators that have voted on the attestation is higher or equal to the threshold,
-			// process the attestation, set Observed to true, and break
-			if eventVotePower.GTE(requiredPower) {
-				lastEventNonce := k.GetLastObservedEventNonce(ctx)
-				// this check is performed at the next level up so this should never panic
-				// outside of programmer error.
-				if event.GetEventNonce() != lastEventNonce+1 {
-					panic("attempting to apply events to state out of order")
-				}
-				k.setLastObservedEventNonce(ctx, event.GetEventNonce())
-				k.SetLastObservedEthereumBlockHeight(ctx, event.GetEthereumHeight())
-
-				eventVoteRecord.Accepted = true
-				k.setEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash(), eventVoteRecord)
-
-				k.processEthereumEvent(ctx, event)
-				ctx.EventManager().EmitEvent(sdk.NewEvent(
-					types.EventTypeObservation,
-					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-					sdk.NewAttribute(types.AttributeKeyEthereumEventType, fmt.Sprintf("%T", event)),
-					sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
-					sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
-					sdk.NewAttribute(types.AttributeKeyEthereumEventVoteRecordID,
-						string(types.MakeEthereumEventVoteRecordKey(event.GetEventNonce(), event.Hash()))),
-					sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.GetEventNonce())),
-				))
-
-				break
+		// Compare the power the validators had when they voted with the threshold of the event type
+		// applied to the total power when the record was created
+		if eventVoteRecord.VotePower.GTE(k.eventVoteRecordRequiredPower(ctx, eventVoteRecord, event)) {
+			lastEventNonce := k.GetLastObservedEventNonce(ctx)
+			// this check is performed at the next level up so this should never panic
+			// outside of programmer error.
+			if event.GetEventNonce() != lastEventNonce+1 {
+				panic("attempting to apply events to state out of order")
 			}
+			k.setLastObservedEventNonce(ctx, event.GetEventNonce())
+			k.SetLastObservedEthereumBlockHeight(ctx, event.GetEthereumHeight())
+
+			eventVoteRecord.Accepted = true
+			eventVoteRecord.Height = uint64(ctx.BlockHeight())
+			k.setEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash(), eventVoteRecord)
+			k.slashConflictingEventVotes(ctx, event.GetEventNonce())
+
+			k.processEthereumEvent(ctx, event)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeObservation,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyEthereumEventType, fmt.Sprintf("%T", event)),
+				sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+				sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+				sdk.NewAttribute(types.AttributeKeyEthereumEventVoteRecordID,
+					string(types.MakeEthereumEventVoteRecordKey(event.GetEventNonce(), event.Hash()))),
+				sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.GetEventNonce())),
+			))
 		}
 	} else {
 		// We panic here because this should never happen
@@ -114,6 +123,96 @@ func (k Keeper) TryEventVoteRecord(ctx sdk.Context, eventVoteRecord *types.Ether
 	}
 }
 
+// TallyEthereumEventVoteRecords tries the event vote records one nonce past the last observed event nonce,
+// and keeps going with the next nonce for as long as one of the records at the nonce is observed. There can
+// be several records at one nonce when validators disagree about what event happened at that nonce. Only the
+// records that can be observed are loaded, so the cost doesn't grow with the number of records in the store.
+func (k Keeper) TallyEthereumEventVoteRecords(ctx sdk.Context) {
+	for {
+		observed := false
+		for _, eventVoteRecord := range k.GetEthereumEventVoteRecordsByNonce(ctx, k.GetLastObservedEventNonce(ctx)+1) {
+			k.TryEventVoteRecord(ctx, eventVoteRecord)
+			// every other record at the nonce is skipped once one of them is observed
+			if eventVoteRecord.Accepted {
+				observed = true
+				break
+			}
+		}
+		if !observed {
+			return
+		}
+	}
+}
+
+// slashConflictingEventVotes slashes and jails the validators who voted for an event that conflicts with the
+// accepted event at the given nonce
+func (k Keeper) slashConflictingEventVotes(ctx sdk.Context, eventNonce uint64) {
+	for _, eventVoteRecord := range k.GetEthereumEventVoteRecordsByNonce(ctx, eventNonce) {
+		if eventVoteRecord.Accepted {
+			continue
+		}
+
+		event, err := types.UnpackEvent(eventVoteRecord.Event)
+		if err != nil {
+			panic(err)
+		}
+
+		for _, vote := range eventVoteRecord.Votes {
+			valAddr, _ := sdk.ValAddressFromBech32(vote.Validator)
+			k.slashConflictingEventVote(ctx, event, valAddr)
+		}
+	}
+}
+
+// slashConflictingEventVote slashes and jails a validator who voted for an event that conflicts with the
+// accepted event at its nonce, and names it in an event. Validators that are already jailed or unbonded
+// are skipped.
+func (k Keeper) slashConflictingEventVote(ctx sdk.Context, event types.EthereumEvent, valAddr sdk.ValAddress) {
+	val, found := k.StakingKeeper.GetValidator(ctx, valAddr)
+	if !found || val.IsJailed() || val.IsUnbonded() {
+		return
+	}
+
+	cons, _ := val.GetConsAddr()
+	k.StakingKeeper.Slash(
+		ctx,
+		cons,
+		ctx.BlockHeight(),
+		val.ConsensusPower(k.PowerReduction),
+		k.GetParams(ctx).SlashFractionConflictingEthereumSignature,
+	)
+	k.StakingKeeper.Jail(ctx, cons)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeConflictingEventVote,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyEthereumEventVoteRecordID,
+			string(types.MakeEthereumEventVoteRecordKey(event.GetEventNonce(), event.Hash()))),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.GetEventNonce())),
+		sdk.NewAttribute(types.AttributeKeyValidatorAddr, valAddr.String()),
+	))
+}
+
+// eventVoteRecordRequiredPower returns the power the votes for the event must add up to for it to be observed
+func (k Keeper) eventVoteRecordRequiredPower(ctx sdk.Context, eventVoteRecord *types.EthereumEventVoteRecord, event types.EthereumEvent) sdk.Int {
+	threshold := k.GetParams(ctx).EventVoteThresholdOf(proto.MessageName(event))
+	return types.EventVoteRecordPowerThreshold(eventVoteRecord.TotalPower, threshold)
+}
+
+// fillEventVoteRecordPowers sets the powers of a record that was stored without them, e.g. before the powers
+// were snapshotted on records, to the power of its votes and the current total power
+func (k Keeper) fillEventVoteRecordPowers(ctx sdk.Context, eventVoteRecord *types.EthereumEventVoteRecord) {
+	if eventVoteRecord.VotePower.IsNil() {
+		eventVoteRecord.VotePower = sdk.ZeroInt()
+		for _, vote := range eventVoteRecord.Votes {
+			eventVoteRecord.VotePower = eventVoteRecord.VotePower.Add(sdk.NewInt(vote.Power))
+		}
+	}
+	if eventVoteRecord.TotalPower.IsNil() {
+		eventVoteRecord.TotalPower = k.StakingKeeper.GetLastTotalPower(ctx)
+	}
+}
+
 // processEthereumEvent actually applies the attestation to the consensus state
 func (k Keeper) processEthereumEvent(ctx sdk.Context, event types.EthereumEvent) {
 	// then execute in a new Tx so that we can store state on failure
@@ -168,6 +267,19 @@ func (k Keeper) GetEthereumEventVoteRecordMapping(ctx sdk.Context) (out map[uint
 	return
 }
 
+// GetEthereumEventVoteRecordsByNonce returns all the vote records at a given event nonce
+func (k Keeper) GetEthereumEventVoteRecordsByNonce(ctx sdk.Context, eventNonce uint64) (out []*types.EthereumEventVoteRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeEthereumEventVoteRecordKey(eventNonce, nil))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		eventVoteRecord := &types.EthereumEventVoteRecord{}
+		k.cdc.MustUnmarshal(iter.Value(), eventVoteRecord)
+		out = append(out, eventVoteRecord)
+	}
+	return
+}
+
 // iterateEthereumEventVoteRecords iterates through all attestations
 func (k Keeper) iterateEthereumEventVoteRecords(ctx sdk.Context, cb func([]byte, *types.EthereumEventVoteRecord) bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumEventVoteRecordKey})
@@ -183,6 +295,133 @@ func (k Keeper) iterateEthereumEventVoteRecords(ctx sdk.Context, cb func([]byte,
 	}
 }
 
+// GetUnSlashedEthereumEventVoteRecords returns the accepted event vote records that were observed
+// before maxHeight and have not yet been checked for slashing, in event nonce order
+func (k Keeper) GetUnSlashedEthereumEventVoteRecords(ctx sdk.Context, maxHeight uint64) (out []*types.EthereumEventVoteRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumEventVoteRecordKey})
+	iter := store.Iterator(sdk.Uint64ToBigEndian(k.GetLastSlashedEventNonce(ctx)+1), nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		eventVoteRecord := &types.EthereumEventVoteRecord{}
+		k.cdc.MustUnmarshal(iter.Value(), eventVoteRecord)
+		if !eventVoteRecord.Accepted {
+			continue
+		}
+		// records are observed in nonce order, so every record after this one is too recent as well
+		if eventVoteRecord.Height >= maxHeight {
+			break
+		}
+		out = append(out, eventVoteRecord)
+	}
+	return
+}
+
+// PruneEthereumEventVoteRecords deletes the event vote records at the nonces below the last observed event nonce
+// that were checked for slashing and observed before maxHeight, and keeps a summary of the observed events
+func (k Keeper) PruneEthereumEventVoteRecords(ctx sdk.Context, maxHeight uint64) {
+	end := k.GetLastObservedEventNonce(ctx)
+	if lastSlashed := k.GetLastSlashedEventNonce(ctx); lastSlashed < end {
+		end = lastSlashed + 1
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumEventVoteRecordKey})
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(end))
+
+	var (
+		keys      [][]byte
+		summaries []types.EthereumEventSummary
+	)
+	for ; iter.Valid(); iter.Next() {
+		eventVoteRecord := &types.EthereumEventVoteRecord{}
+		k.cdc.MustUnmarshal(iter.Value(), eventVoteRecord)
+		nonce := binary.BigEndian.Uint64(iter.Key()[:8])
+		if eventVoteRecord.Accepted {
+			// records are observed in nonce order, so every record after this one is too recent as well
+			if eventVoteRecord.Height >= maxHeight {
+				end = nonce
+				break
+			}
+			summaries = append(summaries, types.EthereumEventSummary{
+				EventNonce: nonce,
+				EventHash:  iter.Key()[8:],
+				Height:     eventVoteRecord.Height,
+			})
+		}
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		// conflicting records at the nonce of the first record that is too recent are kept with it
+		if binary.BigEndian.Uint64(key[:8]) < end {
+			store.Delete(key)
+		}
+	}
+	for _, summary := range summaries {
+		k.setEthereumEventSummary(ctx, summary)
+	}
+}
+
+// setEthereumEventSummary sets the summary of an observed event whose vote records were pruned
+func (k Keeper) setEthereumEventSummary(ctx sdk.Context, summary types.EthereumEventSummary) {
+	ctx.KVStore(k.storeKey).Set(types.MakeEthereumEventSummaryKey(summary.EventNonce), k.cdc.MustMarshal(&summary))
+}
+
+// iterateEthereumEventSummaries iterates through the summaries of the pruned observed events in event
+// nonce order
+func (k Keeper) iterateEthereumEventSummaries(ctx sdk.Context, cb func(types.EthereumEventSummary) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumEventSummaryKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var summary types.EthereumEventSummary
+		k.cdc.MustUnmarshal(iter.Value(), &summary)
+		if cb(summary) {
+			break
+		}
+	}
+}
+
+// GetEthereumEventSummary returns the hash and observation height of the event observed at the nonce,
+// whether its vote records were pruned or not
+func (k Keeper) GetEthereumEventSummary(ctx sdk.Context, eventNonce uint64) *types.EthereumEventSummary {
+	if bz := ctx.KVStore(k.storeKey).Get(types.MakeEthereumEventSummaryKey(eventNonce)); bz != nil {
+		var summary types.EthereumEventSummary
+		k.cdc.MustUnmarshal(bz, &summary)
+		return &summary
+	}
+
+	for _, eventVoteRecord := range k.GetEthereumEventVoteRecordsByNonce(ctx, eventNonce) {
+		if !eventVoteRecord.Accepted {
+			continue
+		}
+		event, err := types.UnpackEvent(eventVoteRecord.Event)
+		if err != nil {
+			panic(err)
+		}
+		return &types.EthereumEventSummary{
+			EventNonce: eventNonce,
+			EventHash:  event.Hash(),
+			Height:     eventVoteRecord.Height,
+		}
+	}
+	return nil
+}
+
+// SetLastSlashedEventNonce sets the nonce of the latest event vote record checked for slashing
+func (k Keeper) SetLastSlashedEventNonce(ctx sdk.Context, nonce uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LastSlashedEventNonceKey}, sdk.Uint64ToBigEndian(nonce))
+}
+
+// GetLastSlashedEventNonce returns the nonce of the latest event vote record checked for slashing
+func (k Keeper) GetLastSlashedEventNonce(ctx sdk.Context) uint64 {
+	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastSlashedEventNonceKey}); bz == nil {
+		return 0
+	} else {
+		return binary.BigEndian.Uint64(bz)
+	}
+}
+
 // GetLastObservedEventNonce returns the latest observed event nonce
 func (k Keeper) GetLastObservedEventNonce(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
@@ -213,12 +452,16 @@ func (k Keeper) GetLastObservedEthereumBlockHeight(ctx sdk.Context) types.Latest
 
 // SetLastObservedEthereumBlockHeight sets the block height in the store.
 func (k Keeper) SetLastObservedEthereumBlockHeight(ctx sdk.Context, ethereumHeight uint64) {
-	store := ctx.KVStore(k.storeKey)
-	height := types.LatestEthereumBlockHeight{
+	k.setLatestEthereumBlockHeight(ctx, types.LatestEthereumBlockHeight{
 		EthereumHeight: ethereumHeight,
 		CosmosHeight:   uint64(ctx.BlockHeight()),
-	}
-	store.Set([]byte{types.LastEthereumBlockHeightKey}, k.cdc.MustMarshal(&height))
+	})
+}
+
+// setLatestEthereumBlockHeight sets the last observed ethereum block height along with the cosmos height
+// it was observed at
+func (k Keeper) setLatestEthereumBlockHeight(ctx sdk.Context, height types.LatestEthereumBlockHeight) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LastEthereumBlockHeightKey}, k.cdc.MustMarshal(&height))
 }
 
 // setLastObservedEventNonce sets the latest observed event nonce
@@ -248,19 +491,25 @@ func (k Keeper) getLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValA
 		// params.SignedClaimsWindow we may have no attestations in our nonce. At which point
 		// the last observed which is a persistent and never cleaned counter will suffice.
 		lowestObserved := k.GetLastObservedEventNonce(ctx)
-		attmap := k.GetEthereumEventVoteRecordMapping(ctx)
+		iter := prefix.NewStore(store, []byte{types.EthereumEventVoteRecordKey}).Iterator(nil, nil)
+		defer iter.Close()
 		// no new claims in params.SignedClaimsWindow, we can return the current value
 		// because the validator can't be slashed for an event that has already passed.
 		// so they only have to worry about the *next* event to occur
-		if len(attmap) == 0 {
+		if !iter.Valid() {
 			return lowestObserved
 		}
-		for nonce, atts := range attmap {
-			for att := range atts {
-				if atts[att].Accepted && nonce < lowestObserved {
-					lowestObserved = nonce
-				}
+		// records are stored in event nonce order, so the first accepted one is the lowest
+		for ; iter.Valid(); iter.Next() {
+			var eventVoteRecord types.EthereumEventVoteRecord
+			k.cdc.MustUnmarshal(iter.Value(), &eventVoteRecord)
+			if !eventVoteRecord.Accepted {
+				continue
+			}
+			if nonce := binary.BigEndian.Uint64(iter.Key()[:8]); nonce < lowestObserved {
+				lowestObserved = nonce
 			}
+			break
 		}
 		// return the latest event minus one so that the validator
 		// can submit that event and avoid slashing. special case
@@ -278,3 +527,15 @@ func (k Keeper) setLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValA
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.MakeLastEventNonceByValidatorKey(validator), sdk.Uint64ToBigEndian(nonce))
 }
+
+// iterateLastEventNonceByValidator iterates through the latest event nonce of every validator that voted
+func (k Keeper) iterateLastEventNonceByValidator(ctx sdk.Context, cb func(validator sdk.ValAddress, nonce uint64) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.LastEventNonceByValidatorKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(sdk.ValAddress(iter.Key()), binary.BigEndian.Uint64(iter.Value())) {
+			break
+		}
+	}
+}
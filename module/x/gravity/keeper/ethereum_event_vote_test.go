@@ -0,0 +1,295 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+func TestEventVoteThreshold(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	params := k.GetParams(ctx)
+	params.EventVoteThreshold = sdk.NewDecWithPrec(55, 2)
+	params.EventVoteThresholds = []types.EventVoteThreshold{
+		{EventType: "gravity.v1.ERC20DeployedEvent", Threshold: sdk.NewDecWithPrec(9, 1)},
+	}
+	k.SetParams(ctx, params)
+
+	vote := func(event types.EthereumEvent, vals ...sdk.ValAddress) *types.EthereumEventVoteRecord {
+		var record *types.EthereumEventVoteRecord
+		for _, val := range vals {
+			var err error
+			record, err = k.recordEventVote(ctx, event, val)
+			require.NoError(t, err)
+		}
+		if !record.Accepted {
+			k.TryEventVoteRecord(ctx, record)
+		}
+		return record
+	}
+
+	// three of five validators pass the default threshold
+	deposit := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  TokenContractAddrs[0],
+		Amount:         sdk.NewInt(100),
+		EthereumSender: EthAddrs[0].Hex(),
+		CosmosReceiver: AccAddrs[0].String(),
+		EthereumHeight: 10,
+	}
+	require.True(t, vote(deposit, ValAddrs[0], ValAddrs[1], ValAddrs[2]).Accepted)
+	require.Equal(t, uint64(1), k.GetLastObservedEventNonce(ctx))
+
+	// the remaining validators still vote for it
+	vote(deposit, ValAddrs[3], ValAddrs[4])
+
+	// deployments need a higher quorum
+	deployed := &types.ERC20DeployedEvent{
+		EventNonce:     2,
+		CosmosDenom:    "stake",
+		TokenContract:  TokenContractAddrs[1],
+		Erc20Name:      "stake",
+		Erc20Symbol:    "stake",
+		EthereumHeight: 11,
+	}
+	require.False(t, vote(deployed, ValAddrs[0], ValAddrs[1], ValAddrs[2], ValAddrs[3]).Accepted)
+
+	res, err := k.EventVoteRecordTallies(sdk.WrapSDKContext(ctx), &types.EventVoteRecordTalliesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Tallies, 1)
+	tally := res.Tallies[0]
+	require.Equal(t, uint64(2), tally.EventNonce)
+	require.Equal(t, "gravity.v1.ERC20DeployedEvent", tally.EventType)
+	require.Equal(t, sdk.NewDecWithPrec(9, 1), tally.Threshold)
+	require.Len(t, tally.Votes, 4)
+	require.True(t, tally.VotePower.LT(tally.RequiredPower))
+
+	require.True(t, vote(deployed, ValAddrs[4]).Accepted)
+	require.Equal(t, uint64(2), k.GetLastObservedEventNonce(ctx))
+
+	res, err = k.EventVoteRecordTallies(sdk.WrapSDKContext(ctx), &types.EventVoteRecordTalliesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Tallies)
+}
+
+func TestEventVoteRecordPowerSnapshot(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	deposit := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  TokenContractAddrs[0],
+		Amount:         sdk.NewInt(100),
+		EthereumSender: EthAddrs[0].Hex(),
+		CosmosReceiver: AccAddrs[0].String(),
+		EthereumHeight: 10,
+	}
+
+	var record *types.EthereumEventVoteRecord
+	for _, val := range ValAddrs[:3] {
+		var err error
+		record, err = k.recordEventVote(ctx, deposit, val)
+		require.NoError(t, err)
+	}
+	k.TryEventVoteRecord(ctx, record)
+	require.False(t, record.Accepted)
+
+	power := input.StakingKeeper.GetLastValidatorPower(ctx, ValAddrs[0])
+	require.Equal(t, uint64(ctx.BlockHeight()), record.CreationHeight)
+	require.Equal(t, sdk.NewInt(5*power), record.TotalPower)
+	require.Equal(t, sdk.NewInt(3*power), record.VotePower)
+	require.Equal(t, types.EthereumEventVote{Validator: ValAddrs[2].String(), Power: power}, record.Votes[2])
+
+	// the validators that didn't vote lose their power, the record is still tallied against
+	// the total power when it was created
+	input.StakingKeeper.SetLastValidatorPower(ctx, ValAddrs[3], 0)
+	input.StakingKeeper.SetLastValidatorPower(ctx, ValAddrs[4], 0)
+	input.StakingKeeper.SetLastTotalPower(ctx, sdk.NewInt(3*power))
+	k.TryEventVoteRecord(ctx, record)
+	require.False(t, record.Accepted)
+
+	// a vote without power doesn't count
+	record, err := k.recordEventVote(ctx, deposit, ValAddrs[3])
+	require.NoError(t, err)
+	k.TryEventVoteRecord(ctx, record)
+	require.False(t, record.Accepted)
+	require.Equal(t, sdk.NewInt(3*power), record.VotePower)
+}
+
+func TestEventVoteRecordWithoutPowers(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	power := input.StakingKeeper.GetLastValidatorPower(ctx, ValAddrs[0])
+
+	deposit := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  TokenContractAddrs[0],
+		Amount:         sdk.NewInt(100),
+		EthereumSender: EthAddrs[0].Hex(),
+		CosmosReceiver: AccAddrs[0].String(),
+		EthereumHeight: 10,
+	}
+	any, err := types.PackEvent(deposit)
+	require.NoError(t, err)
+
+	anyBz, err := any.Marshal()
+	require.NoError(t, err)
+
+	// the record was stored without the powers, e.g. before they were snapshotted on records
+	bz := protowire.AppendTag(nil, 1, protowire.BytesType)
+	bz = protowire.AppendBytes(bz, anyBz)
+	for _, val := range ValAddrs[:3] {
+		vote := types.EthereumEventVote{Validator: val.String(), Power: power}
+		voteBz, err := vote.Marshal()
+		require.NoError(t, err)
+		bz = protowire.AppendTag(bz, 5, protowire.BytesType)
+		bz = protowire.AppendBytes(bz, voteBz)
+	}
+	ctx.KVStore(k.storeKey).Set(types.MakeEthereumEventVoteRecordKey(deposit.EventNonce, deposit.Hash()), bz)
+
+	res, err := k.EventVoteRecordTallies(sdk.WrapSDKContext(ctx), &types.EventVoteRecordTalliesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Tallies, 1)
+	require.Equal(t, sdk.NewInt(3*power), res.Tallies[0].VotePower)
+
+	require.NotPanics(t, func() { k.TallyEthereumEventVoteRecords(ctx) })
+	require.Equal(t, uint64(0), k.GetLastObservedEventNonce(ctx))
+
+	record, err := k.recordEventVote(ctx, deposit, ValAddrs[3])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(4*power), record.VotePower)
+	require.Equal(t, sdk.NewInt(5*power), record.TotalPower)
+	k.TryEventVoteRecord(ctx, record)
+	require.True(t, record.Accepted)
+}
+
+// BenchmarkEventVoteRecordTally measures the per block cost of tallying with 100k observed records in the store
+func BenchmarkEventVoteRecordTally(b *testing.B) {
+	input, ctx := SetupFiveValChain(b)
+	k := input.GravityKeeper
+
+	const observed = 100000
+	for nonce := uint64(1); nonce <= observed; nonce++ {
+		event := &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  TokenContractAddrs[0],
+			Amount:         sdk.NewInt(100),
+			EthereumSender: EthAddrs[0].Hex(),
+			CosmosReceiver: AccAddrs[0].String(),
+			EthereumHeight: nonce,
+		}
+		any, err := types.PackEvent(event)
+		require.NoError(b, err)
+		k.setEthereumEventVoteRecord(ctx, nonce, event.Hash(), &types.EthereumEventVoteRecord{
+			Event:      any,
+			Votes:      []types.EthereumEventVote{{Validator: ValAddrs[0].String(), Power: 100}},
+			Accepted:   true,
+			Height:     nonce,
+			VotePower:  sdk.NewInt(100),
+			TotalPower: sdk.NewInt(100),
+		})
+	}
+	k.setLastObservedEventNonce(ctx, observed)
+
+	// one record is still being voted on
+	pending := &types.SendToCosmosEvent{
+		EventNonce:     observed + 1,
+		TokenContract:  TokenContractAddrs[0],
+		Amount:         sdk.NewInt(100),
+		EthereumSender: EthAddrs[0].Hex(),
+		CosmosReceiver: AccAddrs[0].String(),
+		EthereumHeight: observed + 1,
+	}
+	any, err := types.PackEvent(pending)
+	require.NoError(b, err)
+	k.setEthereumEventVoteRecord(ctx, pending.EventNonce, pending.Hash(), &types.EthereumEventVoteRecord{
+		Event:      any,
+		Votes:      []types.EthereumEventVote{{Validator: ValAddrs[0].String(), Power: 20}},
+		VotePower:  sdk.NewInt(20),
+		TotalPower: sdk.NewInt(100),
+	})
+
+	b.Run("tally", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			k.TallyEthereumEventVoteRecords(ctx)
+		}
+		require.Equal(b, uint64(observed), k.GetLastObservedEventNonce(ctx))
+	})
+
+	b.Run("last event nonce of new validator", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			k.getLastEventNonceByValidator(ctx, ValAddrs[1])
+		}
+	})
+}
+
+func TestPruneEthereumEventVoteRecords(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	deposit := func(nonce uint64, amount int64) *types.SendToCosmosEvent {
+		return &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  TokenContractAddrs[0],
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: EthAddrs[0].Hex(),
+			CosmosReceiver: AccAddrs[0].String(),
+			EthereumHeight: nonce,
+		}
+	}
+	set := func(event types.EthereumEvent, accepted bool, height uint64) {
+		any, err := types.PackEvent(event)
+		require.NoError(t, err)
+		k.setEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash(), &types.EthereumEventVoteRecord{
+			Event:      any,
+			Accepted:   accepted,
+			Height:     height,
+			VotePower:  sdk.ZeroInt(),
+			TotalPower: sdk.ZeroInt(),
+		})
+	}
+
+	// events 1 to 3 were observed at heights 10, 20 and 30, with a conflicting record at nonce 2
+	set(deposit(1, 100), true, 10)
+	set(deposit(2, 100), true, 20)
+	set(deposit(2, 200), false, 0)
+	set(deposit(3, 100), true, 30)
+	set(deposit(4, 100), false, 0)
+	k.setLastObservedEventNonce(ctx, 3)
+
+	// nothing is pruned before it was checked for slashing
+	k.PruneEthereumEventVoteRecords(ctx, 100)
+	require.Len(t, k.GetEthereumEventVoteRecordsByNonce(ctx, 1), 1)
+
+	// records observed at or after the max height are kept with their conflicting records
+	k.SetLastSlashedEventNonce(ctx, 3)
+	k.PruneEthereumEventVoteRecords(ctx, 20)
+	require.Empty(t, k.GetEthereumEventVoteRecordsByNonce(ctx, 1))
+	require.Len(t, k.GetEthereumEventVoteRecordsByNonce(ctx, 2), 2)
+
+	// the records at the last observed nonce and above are kept
+	k.PruneEthereumEventVoteRecords(ctx, 100)
+	require.Empty(t, k.GetEthereumEventVoteRecordsByNonce(ctx, 2))
+	require.Len(t, k.GetEthereumEventVoteRecordsByNonce(ctx, 3), 1)
+	require.Len(t, k.GetEthereumEventVoteRecordsByNonce(ctx, 4), 1)
+
+	// the observed events are still queryable
+	res, err := k.EthereumEventSummary(sdk.WrapSDKContext(ctx), &types.EthereumEventSummaryRequest{EventNonce: 2})
+	require.NoError(t, err)
+	require.Equal(t, types.EthereumEventSummary{EventNonce: 2, EventHash: deposit(2, 100).Hash(), Height: 20}, *res.Summary)
+
+	res, err = k.EthereumEventSummary(sdk.WrapSDKContext(ctx), &types.EthereumEventSummaryRequest{EventNonce: 3})
+	require.NoError(t, err)
+	require.Equal(t, types.EthereumEventSummary{EventNonce: 3, EventHash: deposit(3, 100).Hash(), Height: 30}, *res.Summary)
+
+	_, err = k.EthereumEventSummary(sdk.WrapSDKContext(ctx), &types.EthereumEventSummaryRequest{EventNonce: 4})
+	require.Error(t, err)
+}
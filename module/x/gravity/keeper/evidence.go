@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// checkBadSignatureEvidence verifies that the signature is over the checkpoint of an outgoing tx the chain
+// never produced, and slashes and tombstones the validators whose ethereum key made it
+func (k Keeper) checkBadSignatureEvidence(ctx sdk.Context, subject types.OutgoingTx, signature []byte) ([]sdk.ValAddress, error) {
+	checkpoint := subject.GetCheckpoint([]byte(k.getGravityID(ctx)))
+
+	if k.isPastEthereumSignatureCheckpoint(ctx, checkpoint) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "checkpoint %s exists, cannot slash", hex.EncodeToString(checkpoint))
+	}
+
+	if err := k.checkBadSignatureEvidenceActivation(ctx, subject); err != nil {
+		return nil, err
+	}
+
+	ethAddress, err := types.EthereumAddressFromSignature(checkpoint, signature)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not recover ethereum signer")
+	}
+
+	vals := k.getValidatorsByEthereumAddress(ctx, ethAddress)
+	if len(vals) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "no validator for ethereum address %s", ethAddress)
+	}
+
+	// every validator the ethereum address maps to is punished, so the store order doesn't decide which one is
+	var slashed []sdk.ValAddress
+	for _, val := range vals {
+		if err = k.slashBadEthereumSignature(ctx, val); err == nil {
+			slashed = append(slashed, val)
+		}
+	}
+	if len(slashed) == 0 {
+		return nil, err
+	}
+	return slashed, nil
+}
+
+// slashBadEthereumSignature slashes, jails and tombstones a validator whose ethereum key signed a checkpoint
+// the chain never produced
+func (k Keeper) slashBadEthereumSignature(ctx sdk.Context, val sdk.ValAddress) error {
+	validator, found := k.StakingKeeper.GetValidator(ctx, val)
+	if !found {
+		return sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, val.String())
+	}
+	if validator.IsUnbonded() {
+		return sdkerrors.Wrapf(types.ErrInvalid, "validator %s is unbonded", val)
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+	if k.SlashingKeeper.IsTombstoned(ctx, consAddr) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "validator %s is already tombstoned", val)
+	}
+
+	k.StakingKeeper.Slash(
+		ctx,
+		consAddr,
+		ctx.BlockHeight(),
+		validator.ConsensusPower(k.PowerReduction),
+		k.GetParams(ctx).SlashFractionBadEthereumSignature,
+	)
+	if !validator.IsJailed() {
+		k.StakingKeeper.Jail(ctx, consAddr)
+	}
+	k.SlashingKeeper.Tombstone(ctx, consAddr)
+
+	return nil
+}
+
+// checkBadSignatureEvidenceActivation refuses evidence against outgoing txs that may have been produced and deleted
+// before the chain indexed their checkpoints, the txs of an upgraded chain up to the activation of the evidence
+func (k Keeper) checkBadSignatureEvidenceActivation(ctx sdk.Context, subject types.OutgoingTx) error {
+	activation := k.getBadSignatureEvidenceActivation(ctx)
+	if activation == nil {
+		return nil
+	}
+
+	switch subject := subject.(type) {
+	case *types.SignerSetTx:
+		if subject.Nonce <= activation.SignerSetTxNonce {
+			return sdkerrors.Wrapf(types.ErrInvalid, "signer set tx nonce %d is not after the evidence activation nonce %d", subject.Nonce, activation.SignerSetTxNonce)
+		}
+	case *types.BatchTx:
+		if subject.BatchNonce <= activation.BatchNonce {
+			return sdkerrors.Wrapf(types.ErrInvalid, "batch tx nonce %d is not after the evidence activation nonce %d", subject.BatchNonce, activation.BatchNonce)
+		}
+	case *types.ContractCallTx:
+		// invalidation nonces are per scope, so the contract call txs are told apart by their height, and since
+		// the height isn't signed, by a timeout the ones that timed out before the activation can't have
+		if subject.Height <= activation.Height {
+			return sdkerrors.Wrapf(types.ErrInvalid, "contract call tx height %d is not after the evidence activation height %d", subject.Height, activation.Height)
+		}
+		if subject.Timeout <= activation.EthereumHeight {
+			return sdkerrors.Wrapf(types.ErrInvalid, "contract call tx timeout %d is not after the evidence activation ethereum height %d", subject.Timeout, activation.EthereumHeight)
+		}
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalid, "unknown outgoing tx type %T", subject)
+	}
+	return nil
+}
+
+// setBadSignatureEvidenceActivation records the outgoing tx nonces bad signature evidence is accepted after
+func (k Keeper) setBadSignatureEvidenceActivation(ctx sdk.Context, activation types.BadSignatureEvidenceActivation) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.BadSignatureEvidenceActivationKey}, k.cdc.MustMarshal(&activation))
+}
+
+// getBadSignatureEvidenceActivation returns the outgoing tx nonces bad signature evidence is accepted after, or nil
+// if the chain indexed the checkpoints of every outgoing tx it produced
+func (k Keeper) getBadSignatureEvidenceActivation(ctx sdk.Context) *types.BadSignatureEvidenceActivation {
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.BadSignatureEvidenceActivationKey})
+	if bz == nil {
+		return nil
+	}
+	var activation types.BadSignatureEvidenceActivation
+	k.cdc.MustUnmarshal(bz, &activation)
+	return &activation
+}
+
+// setPastEthereumSignatureCheckpoint records a checkpoint produced by the chain
+func (k Keeper) setPastEthereumSignatureCheckpoint(ctx sdk.Context, checkpoint []byte) {
+	ctx.KVStore(k.storeKey).Set(types.MakePastEthereumSignatureCheckpointKey(checkpoint), []byte{0x1})
+}
+
+// iteratePastEthereumSignatureCheckpoints iterates through the checkpoints produced by the chain
+func (k Keeper) iteratePastEthereumSignatureCheckpoints(ctx sdk.Context, cb func(checkpoint []byte) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.PastEthereumSignatureCheckpointKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key()) {
+			break
+		}
+	}
+}
+
+// isPastEthereumSignatureCheckpoint returns true if the checkpoint was produced by the chain
+func (k Keeper) isPastEthereumSignatureCheckpoint(ctx sdk.Context, checkpoint []byte) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MakePastEthereumSignatureCheckpointKey(checkpoint))
+}
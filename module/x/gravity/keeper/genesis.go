@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"errors"
+	"fmt"
+
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -10,7 +13,7 @@ import (
 
 // InitGenesis starts a chain from a genesis state
 func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
-	k.setParams(ctx, *data.Params)
+	k.SetParams(ctx, *data.Params)
 
 	// reset pool transactions in state
 	for _, tx := range data.UnbatchedSendToEthereumTxs {
@@ -26,30 +29,34 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		if err := event.Validate(); err != nil {
 			panic("invalid event in genesis")
 		}
+		k.fillEventVoteRecordPowers(ctx, evr)
 		k.setEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash(), evr)
 	}
 
+	// reset summaries of pruned ethereum event vote records in state
+	for _, summary := range data.EthereumEventSummaries {
+		k.setEthereumEventSummary(ctx, summary)
+	}
+
 	// reset last observed event nonce
 	k.setLastObservedEventNonce(ctx, data.LastObservedEventNonce)
+	if data.LastSlashedEventNonce != 0 {
+		k.SetLastSlashedEventNonce(ctx, data.LastSlashedEventNonce)
+	}
 
 	// reset attestation state of all validators
-	for _, eventVoteRecord := range data.EthereumEventVoteRecords {
-		event, _ := types.UnpackEvent(eventVoteRecord.Event)
-		for _, vote := range eventVoteRecord.Votes {
-			val, err := sdk.ValAddressFromBech32(vote)
-			if err != nil {
-				panic(err)
-			}
-			last := k.getLastEventNonceByValidator(ctx, val)
-			if event.GetEventNonce() > last {
-				k.setLastEventNonceByValidator(ctx, val, event.GetEventNonce())
-			}
+	for _, last := range data.LastEventNoncesByValidator {
+		val, err := sdk.ValAddressFromBech32(last.ValidatorAddress)
+		if err != nil {
+			panic(err)
 		}
+		k.setLastEventNonceByValidator(ctx, val, last.EventNonce)
 	}
 
 	// reset delegate keys in state
 	for _, keys := range data.DelegateKeys {
-		if err := keys.ValidateBasic(); err != nil {
+		// the signature that registered the keys isn't kept in state, so exported keys don't have one
+		if err := keys.ValidateBasic(); err != nil && !errors.Is(err, types.ErrEmptyEthSig) {
 			panic("Invalid delegate key in Genesis!")
 		}
 
@@ -78,16 +85,91 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.SetOutgoingTx(ctx, otx)
 	}
 
-	// reset signatures in state
+	for _, checkpoint := range data.PastEthereumSignatureCheckpoints {
+		k.setPastEthereumSignatureCheckpoint(ctx, checkpoint)
+	}
+	if data.BadSignatureEvidenceActivation != nil {
+		k.setBadSignatureEvidenceActivation(ctx, *data.BadSignatureEvidenceActivation)
+	}
+
+	// reset signatures in state, the delegate keys were set above so the validator
+	// can be found from the ethereum signer
 	for _, confa := range data.Confirmations {
 		conf, err := types.UnpackConfirmation(confa)
 		if err != nil {
 			panic("invalid etheruem signature in genesis")
 		}
-		// TODO: not currently an easy way to get the validator address from the
-		// etherum address here. once we implement the third index for keys
-		// this will be easy.
-		k.SetEthereumSignature(ctx, conf, sdk.ValAddress{})
+		orch := k.GetEthereumOrchestratorAddress(ctx, conf.GetSigner())
+		val := k.GetOrchestratorValidatorAddress(ctx, orch)
+		if orch.Empty() || val.Empty() {
+			panic(fmt.Sprintf("no validator for ethereum signer %s in genesis", conf.GetSigner()))
+		}
+		k.SetEthereumSignature(ctx, conf, val)
+	}
+
+	// reset outgoing tx counters in state
+	if data.LatestSignerSetTxNonce != 0 {
+		k.setLatestSignerSetTxNonce(ctx, data.LatestSignerSetTxNonce)
+	}
+	if data.LastObservedSignerSet != nil {
+		k.setLastObservedSignerSetTx(ctx, *data.LastObservedSignerSet)
+	}
+	if data.LastOutgoingBatchNonce != 0 {
+		k.setLastOutgoingBatchNonce(ctx, data.LastOutgoingBatchNonce)
+	}
+	if data.LastSendToEthereumId != 0 {
+		k.setLastSendToEthereumID(ctx, data.LastSendToEthereumId)
+	}
+	if data.LastEthereumBlockHeight != (types.LatestEthereumBlockHeight{}) {
+		k.setLatestEthereumBlockHeight(ctx, data.LastEthereumBlockHeight)
+	}
+	if data.LastSlashedOutgoingTxBlock != 0 {
+		k.SetLastSlashedOutgoingTxBlockHeight(ctx, data.LastSlashedOutgoingTxBlock)
+	}
+	if data.LastSlashedContractCallTxBlock != 0 {
+		k.SetLastSlashedContractCallTxBlockHeight(ctx, data.LastSlashedContractCallTxBlock)
+	}
+	if data.LastUnbondingBlockHeight != 0 {
+		k.setLastUnbondingBlockHeight(ctx, data.LastUnbondingBlockHeight)
+	}
+
+	// reset missed signature counters in state
+	for _, info := range data.SigningInfos {
+		k.setGravitySigningInfo(ctx, info)
+	}
+	for _, missed := range data.MissedSignatures {
+		val, err := sdk.ValAddressFromBech32(missed.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		for _, index := range missed.MissedIndexes {
+			k.setMissedSignatureBitArray(ctx, val, index, true)
+		}
+	}
+
+	// reset bridge halt and pauses in state
+	if data.BridgeHalted {
+		k.setBridgeHalted(ctx, data.BridgeHaltedSignerSetNonce)
+	}
+	k.setBridgePaused(ctx, data.BridgePaused)
+	for _, contract := range data.PausedTokenContracts {
+		k.setTokenPaused(ctx, common.HexToAddress(contract), true)
+	}
+	for i := range data.ParkedSendToCosmosEvents {
+		k.setParkedSendToCosmosEvent(ctx, &data.ParkedSendToCosmosEvents[i])
+	}
+
+	// reset outflow rate limit usage and held large sends in state
+	for _, bucket := range data.OutflowBuckets {
+		k.setOutflowBucket(ctx, bucket)
+	}
+	for _, pending := range data.PendingSendToEthereums {
+		k.setPendingSendToEthereum(ctx, pending)
+	}
+
+	// reset the ethereum originated ERC20s held by the bridge contract in state
+	for _, escrow := range data.Erc20Escrows {
+		k.setERC20Escrow(ctx, escrow)
 	}
 }
 
@@ -98,19 +180,39 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		p                        = k.GetParams(ctx)
 		outgoingTxs              []*cdctypes.Any
 		ethereumTxConfirmations  []*cdctypes.Any
-		attmap                   = k.GetEthereumEventVoteRecordMapping(ctx)
 		ethereumEventVoteRecords []*types.EthereumEventVoteRecord
+		ethereumEventSummaries   []types.EthereumEventSummary
 		delegates                = k.getDelegateKeys(ctx)
 		lastobserved             = k.GetLastObservedEventNonce(ctx)
+		lastEventNonces          []types.LastEventNonceByValidator
 		erc20ToDenoms            []*types.ERC20ToDenom
 		unbatchedTransfers       = k.getUnbatchedSendToEthereums(ctx)
+		checkpoints              [][]byte
+		signingInfos             []types.GravitySigningInfo
+		missedSignatures         []types.ValidatorMissedSignatures
+		pausedTokens             []string
+		parkedEvents             []types.SendToCosmosEvent
+		outflowBuckets           []types.OutflowBucket
+		pendingSends             []types.PendingSendToEthereum
+		erc20Escrows             []types.ERC20Token
 	)
 
 	// export ethereumEventVoteRecords from state
-	for _, atts := range attmap {
-		// TODO: set height = 0?
-		ethereumEventVoteRecords = append(ethereumEventVoteRecords, atts...)
-	}
+	k.iterateEthereumEventVoteRecords(ctx, func(_ []byte, record *types.EthereumEventVoteRecord) bool {
+		ethereumEventVoteRecords = append(ethereumEventVoteRecords, record)
+		return false
+	})
+	k.iterateEthereumEventSummaries(ctx, func(summary types.EthereumEventSummary) bool {
+		ethereumEventSummaries = append(ethereumEventSummaries, summary)
+		return false
+	})
+	k.iterateLastEventNonceByValidator(ctx, func(val sdk.ValAddress, nonce uint64) bool {
+		lastEventNonces = append(lastEventNonces, types.LastEventNonceByValidator{
+			ValidatorAddress: val.String(),
+			EventNonce:       nonce,
+		})
+		return false
+	})
 
 	// export erc20 to denom relations
 	k.iterateERC20ToDenom(ctx, func(key []byte, erc20ToDenom *types.ERC20ToDenom) bool {
@@ -157,14 +259,84 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		return false
 	})
 
+	k.iteratePastEthereumSignatureCheckpoints(ctx, func(checkpoint []byte) bool {
+		checkpoints = append(checkpoints, checkpoint)
+		return false
+	})
+
+	// export missed signature counters
+	k.IterateGravitySigningInfos(ctx, func(info types.GravitySigningInfo) bool {
+		signingInfos = append(signingInfos, info)
+		val, err := sdk.ValAddressFromBech32(info.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		if indexes := k.getMissedSignatureIndexes(ctx, val); len(indexes) > 0 {
+			missedSignatures = append(missedSignatures, types.ValidatorMissedSignatures{
+				ValidatorAddress: info.ValidatorAddress,
+				MissedIndexes:    indexes,
+			})
+		}
+		return false
+	})
+
+	// export bridge pauses
+	for _, contract := range k.GetPausedTokenContracts(ctx) {
+		pausedTokens = append(pausedTokens, contract.Hex())
+	}
+	k.IterateParkedSendToCosmosEvents(ctx, func(event *types.SendToCosmosEvent) bool {
+		parkedEvents = append(parkedEvents, *event)
+		return false
+	})
+
+	// export outflow rate limit usage and held large sends
+	k.iterateAllOutflowBuckets(ctx, func(bucket types.OutflowBucket) bool {
+		outflowBuckets = append(outflowBuckets, bucket)
+		return false
+	})
+	k.IteratePendingSendToEthereums(ctx, func(pending types.PendingSendToEthereum) bool {
+		pendingSends = append(pendingSends, pending)
+		return false
+	})
+
+	k.IterateERC20Escrows(ctx, func(escrow types.ERC20Token) bool {
+		erc20Escrows = append(erc20Escrows, escrow)
+		return false
+	})
+
+	haltedSignerSetNonce, halted := k.getBridgeHaltedSignerSetNonce(ctx)
+
 	return types.GenesisState{
-		Params:                     &p,
-		LastObservedEventNonce:     lastobserved,
-		OutgoingTxs:                outgoingTxs,
-		Confirmations:              ethereumTxConfirmations,
-		EthereumEventVoteRecords:   ethereumEventVoteRecords,
-		DelegateKeys:               delegates,
-		Erc20ToDenoms:              erc20ToDenoms,
-		UnbatchedSendToEthereumTxs: unbatchedTransfers,
+		Params:                           &p,
+		LastObservedEventNonce:           lastobserved,
+		OutgoingTxs:                      outgoingTxs,
+		Confirmations:                    ethereumTxConfirmations,
+		EthereumEventVoteRecords:         ethereumEventVoteRecords,
+		DelegateKeys:                     delegates,
+		Erc20ToDenoms:                    erc20ToDenoms,
+		UnbatchedSendToEthereumTxs:       unbatchedTransfers,
+		LastOutgoingBatchNonce:           k.getLastOutgoingBatchNonce(ctx),
+		LastSendToEthereumId:             k.getLastSendToEthereumID(ctx),
+		LatestSignerSetTxNonce:           k.GetLatestSignerSetTxNonce(ctx),
+		LastObservedSignerSet:            k.GetLastObservedSignerSetTx(ctx),
+		LastEthereumBlockHeight:          k.GetLastObservedEthereumBlockHeight(ctx),
+		LastSlashedOutgoingTxBlock:       k.GetLastSlashedOutgoingTxBlockHeight(ctx),
+		LastSlashedContractCallTxBlock:   k.GetLastSlashedContractCallTxBlockHeight(ctx),
+		LastSlashedEventNonce:            k.GetLastSlashedEventNonce(ctx),
+		LastUnbondingBlockHeight:         k.GetLastUnbondingBlockHeight(ctx),
+		LastEventNoncesByValidator:       lastEventNonces,
+		PastEthereumSignatureCheckpoints: checkpoints,
+		SigningInfos:                     signingInfos,
+		MissedSignatures:                 missedSignatures,
+		BridgeHalted:                     halted,
+		BridgeHaltedSignerSetNonce:       haltedSignerSetNonce,
+		BridgePaused:                     k.IsBridgePaused(ctx),
+		PausedTokenContracts:             pausedTokens,
+		ParkedSendToCosmosEvents:         parkedEvents,
+		OutflowBuckets:                   outflowBuckets,
+		PendingSendToEthereums:           pendingSends,
+		EthereumEventSummaries:           ethereumEventSummaries,
+		Erc20Escrows:                     erc20Escrows,
+		BadSignatureEvidenceActivation:   k.getBadSignatureEvidenceActivation(ctx),
 	}
 }
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+func TestGenesisRoundTrip(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	ctx = ctx.WithBlockHeight(100)
+	k := input.GravityKeeper
+
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		denom               = types.NewERC20Token(0, myTokenContractAddr.Hex()).GravityCoin().Denom
+	)
+
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(denom, 99999)}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	params := k.GetParams(ctx)
+	params.LargeWithdrawalThresholds = []types.LargeWithdrawalThreshold{{Denom: denom, Amount: sdk.NewInt(1000)}}
+	params.LargeWithdrawalDelay = 10
+	params.OutflowRateLimits = []types.OutflowRateLimit{{Denom: denom, MaxAmount: sdk.NewInt(10000), Window: 20}}
+	params.MinSignedPerWindow = sdk.NewDecWithPrec(5, 1)
+	k.SetParams(ctx, params)
+
+	{ // outgoing txs and their signatures
+		signerSet := k.CreateSignerSetTx(ctx)
+		k.setLastObservedSignerSetTx(ctx, *signerSet)
+
+		for i := int64(1); i <= 3; i++ {
+			_, err := k.createSendToEthereum(ctx, mySender, myReceiver.Hex(), sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, i), 0)
+			require.NoError(t, err)
+		}
+		batch := k.BuildBatchTx(ctx, myTokenContractAddr, 2)
+		require.NotNil(t, batch)
+
+		// held and unbatched sends
+		_, err := k.createSendToEthereum(ctx, mySender, myReceiver.Hex(), sdk.NewInt64Coin(denom, 2000), sdk.NewInt64Coin(denom, 0), 0)
+		require.NoError(t, err)
+		_, err = k.createSendToEthereum(ctx, mySender, myReceiver.Hex(), sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 5), 0)
+		require.NoError(t, err)
+
+		call := &types.ContractCallTx{
+			InvalidationScope: []byte("a-scope"),
+			InvalidationNonce: 1,
+			Address:           myReceiver.Hex(),
+			Height:            90,
+		}
+		k.SetOutgoingTx(ctx, call)
+
+		for i, val := range ValAddrs[:3] {
+			k.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{
+				SignerSetNonce: signerSet.Nonce,
+				EthereumSigner: EthAddrs[i].Hex(),
+				Signature:      []byte("signer-set-signature"),
+			}, val)
+			k.SetEthereumSignature(ctx, &types.BatchTxConfirmation{
+				TokenContract:  batch.TokenContract,
+				BatchNonce:     batch.BatchNonce,
+				EthereumSigner: EthAddrs[i].Hex(),
+				Signature:      []byte("batch-signature"),
+			}, val)
+		}
+		k.SetEthereumSignature(ctx, &types.ContractCallTxConfirmation{
+			InvalidationScope: call.InvalidationScope,
+			InvalidationNonce: call.InvalidationNonce,
+			EthereumSigner:    EthAddrs[3].Hex(),
+			Signature:         []byte("contract-call-signature"),
+		}, ValAddrs[3])
+
+		// a checkpoint of an outgoing tx that was since deleted
+		k.setPastEthereumSignatureCheckpoint(ctx, []byte("a-deleted-checkpoint"))
+		k.setBadSignatureEvidenceActivation(ctx, types.BadSignatureEvidenceActivation{Height: 5, SignerSetTxNonce: 1, BatchNonce: 1})
+	}
+
+	{ // ethereum events
+		for nonce := uint64(1); nonce <= 3; nonce++ {
+			event := &types.SendToCosmosEvent{
+				EventNonce:     nonce,
+				TokenContract:  myTokenContractAddr.Hex(),
+				Amount:         sdk.NewInt(100),
+				EthereumSender: EthAddrs[0].Hex(),
+				CosmosReceiver: AccAddrs[0].String(),
+				EthereumHeight: 10 + nonce,
+			}
+			var record *types.EthereumEventVoteRecord
+			for _, val := range ValAddrs[:4] {
+				var err error
+				record, err = k.recordEventVote(ctx, event, val)
+				require.NoError(t, err)
+			}
+			k.TryEventVoteRecord(ctx, record)
+		}
+		k.setEthereumEventSummary(ctx, types.EthereumEventSummary{EventNonce: 0, EventHash: []byte("a-pruned-event"), Height: 1})
+		k.SetLastSlashedEventNonce(ctx, 1)
+		k.setCosmosOriginatedDenomToERC20(ctx, "ugrav", TokenContractAddrs[1])
+	}
+
+	{ // slashing counters
+		k.SetLastSlashedOutgoingTxBlockHeight(ctx, 50)
+		k.SetLastSlashedContractCallTxBlockHeight(ctx, 40)
+		k.setLastUnbondingBlockHeight(ctx, 30)
+		k.HandleValidatorSignature(ctx, ValAddrs[4], true)
+		k.HandleValidatorSignature(ctx, ValAddrs[4], false)
+		k.HandleValidatorSignature(ctx, ValAddrs[4], true)
+	}
+
+	{ // bridge halt and pauses
+		k.setBridgeHalted(ctx, 2)
+		k.setBridgePaused(ctx, true)
+		k.setTokenPaused(ctx, common.HexToAddress(TokenContractAddrs[2]), true)
+		k.setParkedSendToCosmosEvent(ctx, &types.SendToCosmosEvent{
+			EventNonce:     4,
+			TokenContract:  TokenContractAddrs[2],
+			Amount:         sdk.NewInt(100),
+			EthereumSender: EthAddrs[0].Hex(),
+			CosmosReceiver: AccAddrs[0].String(),
+			EthereumHeight: 20,
+		})
+	}
+
+	genesis := ExportGenesis(ctx, k)
+	require.NoError(t, genesis.ValidateBasic())
+	require.NotZero(t, genesis.LastOutgoingBatchNonce)
+	require.NotZero(t, genesis.LastSendToEthereumId)
+	require.NotNil(t, genesis.LastObservedSignerSet)
+	require.Len(t, genesis.Confirmations, 7)
+	require.Len(t, genesis.LastEventNoncesByValidator, 4)
+	require.Len(t, genesis.MissedSignatures, 1)
+	require.Len(t, genesis.OutflowBuckets, 1)
+	require.Len(t, genesis.PendingSendToEthereums, 1)
+	require.Len(t, genesis.Erc20Escrows, 1)
+	require.NotNil(t, genesis.BadSignatureEvidenceActivation)
+
+	imported := CreateTestEnv(t)
+	InitGenesis(imported.Context, imported.GravityKeeper, genesis)
+
+	storeA := ctx.KVStore(k.storeKey)
+	storeB := imported.Context.KVStore(imported.GravityKeeper.storeKey)
+	failedA, failedB := sdk.DiffKVStores(storeA, storeB, nil)
+	require.Empty(t, failedA)
+	require.Empty(t, failedB)
+
+	require.Equal(t, genesis, ExportGenesis(imported.Context, imported.GravityKeeper))
+}
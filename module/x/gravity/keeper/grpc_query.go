@@ -13,6 +13,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -459,3 +460,138 @@ func (k Keeper) DelegateKeys(c context.Context, req *types.DelegateKeysRequest)
 	}
 	return res, nil
 }
+
+func (k Keeper) GravitySigningInfo(c context.Context, req *types.GravitySigningInfoRequest) (*types.GravitySigningInfoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address %s", req.ValidatorAddress)
+	}
+
+	info, found := k.GetGravitySigningInfo(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no signing info for %s", req.ValidatorAddress)
+	}
+
+	return &types.GravitySigningInfoResponse{SigningInfo: info}, nil
+}
+
+func (k Keeper) GravitySigningInfos(c context.Context, req *types.GravitySigningInfosRequest) (*types.GravitySigningInfosResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.GravitySigningInfosResponse{}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.GravitySigningInfoKey})
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(key []byte, value []byte) error {
+		var info types.GravitySigningInfo
+		if err := k.cdc.Unmarshal(value, &info); err != nil {
+			return err
+		}
+		res.SigningInfos = append(res.SigningInfos, info)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+func (k Keeper) BridgePauseState(c context.Context, req *types.BridgePauseStateRequest) (*types.BridgePauseStateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.BridgePauseStateResponse{
+		GlobalPaused: k.IsBridgePaused(ctx),
+		Halted:       k.IsBridgeHalted(ctx),
+	}
+	for _, contract := range k.GetPausedTokenContracts(ctx) {
+		res.PausedTokenContracts = append(res.PausedTokenContracts, contract.Hex())
+	}
+	return res, nil
+}
+
+func (k Keeper) OutflowRateLimitUsage(c context.Context, req *types.OutflowRateLimitUsageRequest) (*types.OutflowRateLimitUsageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	limit, found := k.GetOutflowRateLimit(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no outflow rate limit for %s", req.Denom)
+	}
+
+	used := k.GetOutflowUsage(ctx, limit)
+	remaining := limit.MaxAmount.Sub(used)
+	if remaining.IsNegative() {
+		// the rate limit was lowered below what was already sent in its window
+		remaining = sdk.ZeroInt()
+	}
+
+	return &types.OutflowRateLimitUsageResponse{
+		RateLimit: limit,
+		Used:      used,
+		Remaining: remaining,
+	}, nil
+}
+
+func (k Keeper) PendingSendToEthereums(c context.Context, req *types.PendingSendToEthereumsRequest) (*types.PendingSendToEthereumsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.PendingSendToEthereumsResponse{}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.PendingSendToEthereumKey})
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(key []byte, value []byte) error {
+		var pending types.PendingSendToEthereum
+		if err := k.cdc.Unmarshal(value, &pending); err != nil {
+			return err
+		}
+		res.PendingSendToEthereums = append(res.PendingSendToEthereums, pending)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+func (k Keeper) EventVoteRecordTallies(c context.Context, req *types.EventVoteRecordTalliesRequest) (*types.EventVoteRecordTalliesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.EventVoteRecordTalliesResponse{}
+
+	// records up to the last observed nonce are either observed or can no longer be
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumEventVoteRecordKey})
+	iter := store.Iterator(sdk.Uint64ToBigEndian(k.GetLastObservedEventNonce(ctx)+1), nil)
+	defer iter.Close()
+
+	params := k.GetParams(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var eventVoteRecord types.EthereumEventVoteRecord
+		k.cdc.MustUnmarshal(iter.Value(), &eventVoteRecord)
+		k.fillEventVoteRecordPowers(ctx, &eventVoteRecord)
+
+		event, err := types.UnpackEvent(eventVoteRecord.Event)
+		if err != nil {
+			return nil, err
+		}
+
+		eventType := proto.MessageName(event)
+		threshold := params.EventVoteThresholdOf(eventType)
+		res.Tallies = append(res.Tallies, types.EventVoteRecordTally{
+			EventNonce:    event.GetEventNonce(),
+			EventHash:     event.Hash(),
+			EventType:     eventType,
+			Votes:         eventVoteRecord.Votes,
+			VotePower:     eventVoteRecord.VotePower,
+			RequiredPower: types.EventVoteRecordPowerThreshold(eventVoteRecord.TotalPower, threshold),
+			Threshold:     threshold,
+		})
+	}
+
+	return res, nil
+}
+
+func (k Keeper) EthereumEventSummary(c context.Context, req *types.EthereumEventSummaryRequest) (*types.EthereumEventSummaryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	summary := k.GetEthereumEventSummary(ctx, req.EventNonce)
+	if summary == nil {
+		return nil, status.Errorf(codes.NotFound, "no event observed at nonce %d", req.EventNonce)
+	}
+	return &types.EthereumEventSummaryResponse{Summary: summary}, nil
+}
@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// RegisterInvariants registers the gravity module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "ethereum-originated-supply", EthereumOriginatedSupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "cosmos-originated-escrow", CosmosOriginatedEscrowInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "unique-send-to-ethereum-ids", UniqueSendToEthereumIDsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the gravity module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if res, stop := EthereumOriginatedSupplyInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		if res, stop := CosmosOriginatedEscrowInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		return UniqueSendToEthereumIDsInvariant(k)(ctx)
+	}
+}
+
+// EthereumOriginatedSupplyInvariant checks that the supply of the vouchers of every ethereum originated ERC20,
+// together with the sends to ethereum of the ERC20 that weren't executed yet, is what the bridge contract holds
+// according to the observed deposits and executed batches
+func EthereumOriginatedSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := k.expectedERC20Escrows(ctx)
+		escrows := make(map[common.Address]sdk.Int)
+		k.IterateERC20Escrows(ctx, func(escrow types.ERC20Token) bool {
+			escrows[common.HexToAddress(escrow.Contract)] = escrow.Amount
+			return false
+		})
+
+		var (
+			msg   string
+			count int
+		)
+		for contract := range mergeKeys(expected, escrows) {
+			if !amountOf(expected, contract).Equal(amountOf(escrows, contract)) {
+				count++
+				msg += fmt.Sprintf("\t%s has %s vouchers and outstanding sends but %s escrowed\n",
+					contract.Hex(), amountOf(expected, contract), amountOf(escrows, contract))
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "ethereum-originated-supply",
+			fmt.Sprintf("amount of ethereum originated ERC20s with a mismatched supply %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// CosmosOriginatedEscrowInvariant checks that the gravity module account holds at least the amount of every
+// cosmos originated denom with an ERC20 that is in a send to ethereum that wasn't executed yet
+func CosmosOriginatedEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		outstanding := k.outstandingSendToEthereumAmounts(ctx)
+		balances := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+
+		var (
+			msg   string
+			count int
+		)
+		for contract, denom := range k.cosmosOriginatedERC20s(ctx) {
+			required := amountOf(outstanding, contract)
+			if balance := balances.AmountOf(denom); balance.LT(required) {
+				count++
+				msg += fmt.Sprintf("\t%s has %s outstanding sends but the module account holds %s\n", denom, required, balance)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "cosmos-originated-escrow",
+			fmt.Sprintf("amount of cosmos originated denoms with an insufficient escrow %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// UniqueSendToEthereumIDsInvariant checks that no send to ethereum is both in the pool and in a batch, or in
+// more than one batch
+func UniqueSendToEthereumIDsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		seen := make(map[uint64]bool)
+
+		var (
+			msg   string
+			count int
+		)
+		k.iterateOutstandingSendToEthereums(ctx, func(send *types.SendToEthereum) bool {
+			if seen[send.Id] {
+				count++
+				msg += fmt.Sprintf("\tsend to ethereum %d is outstanding more than once\n", send.Id)
+			}
+			seen[send.Id] = true
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "unique-send-to-ethereum-ids",
+			fmt.Sprintf("amount of duplicated send to ethereum ids %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// iterateOutstandingSendToEthereums iterates through the sends to ethereum in the pool, in batches and held
+// for the large withdrawal delay
+func (k Keeper) iterateOutstandingSendToEthereums(ctx sdk.Context, cb func(*types.SendToEthereum) (stop bool)) {
+	stopped := false
+	k.IterateUnbatchedSendToEthereums(ctx, func(send *types.SendToEthereum) bool {
+		stopped = cb(send)
+		return stopped
+	})
+	if stopped {
+		return
+	}
+
+	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		batchTx, _ := otx.(*types.BatchTx)
+		for _, send := range batchTx.Transactions {
+			if stopped = cb(send); stopped {
+				break
+			}
+		}
+		return stopped
+	})
+	if stopped {
+		return
+	}
+
+	k.IteratePendingSendToEthereums(ctx, func(pending types.PendingSendToEthereum) bool {
+		return cb(&pending.SendToEthereum)
+	})
+}
+
+// expectedERC20Escrows returns the supply of the vouchers of every ethereum originated ERC20 together with
+// the amounts of the sends to ethereum of it that weren't executed yet
+func (k Keeper) expectedERC20Escrows(ctx sdk.Context) map[common.Address]sdk.Int {
+	expected := k.outstandingSendToEthereumAmounts(ctx)
+	for contract := range k.cosmosOriginatedERC20s(ctx) {
+		delete(expected, contract)
+	}
+
+	k.bankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		tokenContract, err := types.GravityDenomToERC20(coin.Denom)
+		if err != nil {
+			return false
+		}
+		contract := common.HexToAddress(tokenContract)
+		expected[contract] = amountOf(expected, contract).Add(coin.Amount)
+		return false
+	})
+	return expected
+}
+
+// outstandingSendToEthereumAmounts returns the amounts and fees of the sends to ethereum that weren't executed
+// yet by ERC20
+func (k Keeper) outstandingSendToEthereumAmounts(ctx sdk.Context) map[common.Address]sdk.Int {
+	amounts := make(map[common.Address]sdk.Int)
+	k.iterateOutstandingSendToEthereums(ctx, func(send *types.SendToEthereum) bool {
+		contract := common.HexToAddress(send.Erc20Token.Contract)
+		amounts[contract] = amountOf(amounts, contract).Add(send.Erc20Token.Amount).Add(send.Erc20Fee.Amount)
+		return false
+	})
+	return amounts
+}
+
+// cosmosOriginatedERC20s returns the denoms of the cosmos originated ERC20s
+func (k Keeper) cosmosOriginatedERC20s(ctx sdk.Context) map[common.Address]string {
+	denoms := make(map[common.Address]string)
+	k.iterateERC20ToDenom(ctx, func(_ []byte, erc20ToDenom *types.ERC20ToDenom) bool {
+		denoms[common.HexToAddress(erc20ToDenom.Erc20)] = erc20ToDenom.Denom
+		return false
+	})
+	return denoms
+}
+
+func amountOf(amounts map[common.Address]sdk.Int, contract common.Address) sdk.Int {
+	if amount, ok := amounts[contract]; ok {
+		return amount
+	}
+	return sdk.ZeroInt()
+}
+
+func mergeKeys(a, b map[common.Address]sdk.Int) map[common.Address]bool {
+	keys := make(map[common.Address]bool)
+	for key := range a {
+		keys[key] = true
+	}
+	for key := range b {
+		keys[key] = true
+	}
+	return keys
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+func TestInvariants(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	var (
+		mySender         = AccAddrs[0]
+		myReceiver       = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		ethereumContract = common.HexToAddress(TokenContractAddrs[0])
+		cosmosContract   = common.HexToAddress(TokenContractAddrs[1])
+		voucherDenom     = types.NewERC20Token(0, ethereumContract.Hex()).GravityCoin().Denom
+		cosmosDenom      = "ugrav"
+	)
+
+	// vouchers are minted for a deposit and escrowed by the bridge contract
+	require.NoError(t, k.EthereumEventProcessor.Handle(ctx, &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  ethereumContract.Hex(),
+		Amount:         sdk.NewInt(1000),
+		EthereumSender: EthAddrs[0].Hex(),
+		CosmosReceiver: mySender.String(),
+		EthereumHeight: 10,
+	}))
+	require.Equal(t, sdk.NewInt(1000), k.GetERC20Escrow(ctx, ethereumContract))
+
+	k.setCosmosOriginatedDenomToERC20(ctx, cosmosDenom, cosmosContract.Hex())
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, sdk.Coins{sdk.NewInt64Coin(cosmosDenom, 1000)}))
+
+	for i := int64(1); i <= 3; i++ {
+		_, err := k.createSendToEthereum(ctx, mySender, myReceiver.Hex(), sdk.NewInt64Coin(voucherDenom, 100), sdk.NewInt64Coin(voucherDenom, i), 0)
+		require.NoError(t, err)
+		_, err = k.createSendToEthereum(ctx, mySender, myReceiver.Hex(), sdk.NewInt64Coin(cosmosDenom, 100), sdk.NewInt64Coin(cosmosDenom, i), 0)
+		require.NoError(t, err)
+	}
+	batch := k.BuildBatchTx(ctx, ethereumContract, 2)
+	require.NotNil(t, batch)
+	cosmosBatch := k.BuildBatchTx(ctx, cosmosContract, 2)
+	require.NotNil(t, cosmosBatch)
+
+	_, stop := AllInvariants(k)(ctx)
+	require.False(t, stop)
+
+	// the executed batch leaves the bridge contract
+	k.batchTxExecuted(ctx, ethereumContract, batch.BatchNonce)
+	require.Equal(t, sdk.NewInt(1000-205), k.GetERC20Escrow(ctx, ethereumContract))
+	_, stop = AllInvariants(k)(ctx)
+	require.False(t, stop)
+
+	t.Run("vouchers minted without a deposit", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, sdk.Coins{sdk.NewInt64Coin(voucherDenom, 1)}))
+		_, broken := EthereumOriginatedSupplyInvariant(k)(ctx)
+		require.True(t, broken)
+	})
+
+	t.Run("module account drained", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, sdk.Coins{sdk.NewInt64Coin(cosmosDenom, 1)}))
+		_, broken := CosmosOriginatedEscrowInvariant(k)(ctx)
+		require.True(t, broken)
+	})
+
+	t.Run("batched send back in the pool", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		k.setUnbatchedSendToEthereum(ctx, cosmosBatch.Transactions[0])
+		_, broken := UniqueSendToEthereumIDsInvariant(k)(ctx)
+		require.True(t, broken)
+	})
+}
@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"bytes"
 	"encoding/binary"
 	"fmt"
 	"math"
@@ -29,6 +28,7 @@ type Keeper struct {
 	EthereumEventProcessor interface {
 		Handle(sdk.Context, types.EthereumEvent) error
 	}
+	BatchCreationPolicy BatchCreationPolicy
 
 	storeKey       sdk.StoreKey
 	paramSpace     paramtypes.Subspace
@@ -70,6 +70,7 @@ func NewKeeper(
 		keeper:     k,
 		bankKeeper: bankKeeper,
 	}
+	k.BatchCreationPolicy = NewParamsBatchCreationPolicy(k)
 
 	return k
 }
@@ -86,10 +87,15 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 func (k Keeper) incrementLatestSignerSetTxNonce(ctx sdk.Context) uint64 {
 	current := k.GetLatestSignerSetTxNonce(ctx)
 	next := current + 1
-	ctx.KVStore(k.storeKey).Set([]byte{types.LatestSignerSetTxNonceKey}, sdk.Uint64ToBigEndian(next))
+	k.setLatestSignerSetTxNonce(ctx, next)
 	return next
 }
 
+// setLatestSignerSetTxNonce sets the latest valset nonce
+func (k Keeper) setLatestSignerSetTxNonce(ctx sdk.Context, nonce uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LatestSignerSetTxNonceKey}, sdk.Uint64ToBigEndian(nonce))
+}
+
 // GetLatestSignerSetTxNonce returns the latest valset nonce
 func (k Keeper) GetLatestSignerSetTxNonce(ctx sdk.Context) uint64 {
 	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.LatestSignerSetTxNonceKey}); bz != nil {
@@ -152,7 +158,7 @@ func (k Keeper) GetEthereumSignatures(ctx sdk.Context, storeIndex []byte) map[st
 
 // iterateEthereumSignatures iterates through all valset confirms by nonce in ASC order
 func (k Keeper) iterateEthereumSignatures(ctx sdk.Context, storeIndex []byte, cb func(sdk.ValAddress, []byte) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.EthereumSignatureKey}, storeIndex...))
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeEthereumSignaturePrefix(storeIndex))
 	iter := prefixStore.Iterator(nil, nil)
 	defer iter.Close()
 
@@ -164,6 +170,22 @@ func (k Keeper) iterateEthereumSignatures(ctx sdk.Context, storeIndex []byte, cb
 	}
 }
 
+// deleteEthereumSignatures removes all ethereum signatures for a given outgoing tx by store index
+func (k Keeper) deleteEthereumSignatures(ctx sdk.Context, storeIndex []byte) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeEthereumSignaturePrefix(storeIndex))
+	iter := prefixStore.Iterator(nil, nil)
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
+
 /////////////////////////
 //  ORC -> VAL ADDRESS //
 /////////////////////////
@@ -206,13 +228,12 @@ func (k Keeper) GetValidatorEthereumAddress(ctx sdk.Context, valAddr sdk.ValAddr
 }
 
 func (k Keeper) getValidatorsByEthereumAddress(ctx sdk.Context, ethAddr common.Address) (vals []sdk.ValAddress) {
-	iter := ctx.KVStore(k.storeKey).Iterator(nil, nil)
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ValidatorEthereumAddressKey}).Iterator(nil, nil)
+	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
 		if common.BytesToAddress(iter.Value()) == ethAddr {
-			valBs := bytes.TrimPrefix(iter.Key(), []byte{types.ValidatorEthereumAddressKey})
-			val := sdk.ValAddress(valBs)
-			vals = append(vals, val)
+			vals = append(vals, sdk.ValAddress(iter.Key()))
 		}
 	}
 
@@ -240,13 +261,12 @@ func (k Keeper) GetEthereumOrchestratorAddress(ctx sdk.Context, ethAddr common.A
 }
 
 func (k Keeper) getEthereumAddressesByOrchestrator(ctx sdk.Context, orch sdk.AccAddress) (ethAddrs []common.Address) {
-	iter := ctx.KVStore(k.storeKey).Iterator(nil, nil)
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumOrchestratorAddressKey}).Iterator(nil, nil)
+	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
-		if sdk.AccAddress(iter.Value()).String() == orch.String() {
-			ethBs := bytes.TrimPrefix(iter.Key(), []byte{types.EthereumOrchestratorAddressKey})
-			ethAddr := common.BytesToAddress(ethBs)
-			ethAddrs = append(ethAddrs, ethAddr)
+		if orch.Equals(sdk.AccAddress(iter.Value())) {
+			ethAddrs = append(ethAddrs, common.BytesToAddress(iter.Key()))
 		}
 	}
 
@@ -333,8 +353,8 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return
 }
 
-// setParams sets the parameters in the store
-func (k Keeper) setParams(ctx sdk.Context, ps types.Params) {
+// SetParams sets the parameters in the store
+func (k Keeper) SetParams(ctx sdk.Context, ps types.Params) {
 	k.paramSpace.SetParamSet(ctx, &ps)
 }
 
@@ -432,11 +452,13 @@ func (k Keeper) SetOutgoingTx(ctx sdk.Context, outgoing types.OutgoingTx) {
 		types.MakeOutgoingTxKey(outgoing.GetStoreIndex()),
 		k.cdc.MustMarshal(any),
 	)
+	k.setPastEthereumSignatureCheckpoint(ctx, outgoing.GetCheckpoint([]byte(k.getGravityID(ctx))))
 }
 
-// DeleteOutgoingTx deletes a given outgoingtx
+// DeleteOutgoingTx deletes a given outgoingtx along with its signatures
 func (k Keeper) DeleteOutgoingTx(ctx sdk.Context, storeIndex []byte) {
 	ctx.KVStore(k.storeKey).Delete(types.MakeOutgoingTxKey(storeIndex))
+	k.deleteEthereumSignatures(ctx, storeIndex)
 }
 
 func (k Keeper) PaginateOutgoingTxsByType(ctx sdk.Context, pageReq *query.PageRequest, prefixByte byte, cb func(key []byte, outgoing types.OutgoingTx) bool) (*query.PageResponse, error) {
@@ -55,7 +55,7 @@ func TestAttestationIterator(t *testing.T) {
 
 	att1 := &types.EthereumEventVoteRecord{
 		Accepted: true,
-		Votes:    []string{},
+		Votes:    []types.EthereumEventVote{},
 	}
 	dep1 := &types.SendToCosmosEvent{
 		EventNonce:     1,
@@ -66,7 +66,7 @@ func TestAttestationIterator(t *testing.T) {
 	}
 	att2 := &types.EthereumEventVoteRecord{
 		Accepted: true,
-		Votes:    []string{},
+		Votes:    []types.EthereumEventVote{},
 	}
 	dep2 := &types.SendToCosmosEvent{
 		EventNonce:     2,
@@ -131,6 +131,22 @@ func TestDelegateKeys(t *testing.T) {
 	}
 }
 
+func TestGetEthereumAddressesByOrchestrator(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	val, orch, ethAddr := ValAddrs[0], AccAddrs[1], EthAddrs[0]
+	k.SetOrchestratorValidatorAddress(ctx, val, orch)
+	k.setValidatorEthereumAddress(ctx, val, ethAddr)
+	k.setEthereumOrchestratorAddress(ctx, ethAddr, orch)
+
+	require.Equal(t, []common.Address{ethAddr}, k.getEthereumAddressesByOrchestrator(ctx, orch))
+
+	// the validator address is stored as the value of the orchestrator index, which must not be taken for an orchestrator
+	require.Empty(t, k.getEthereumAddressesByOrchestrator(ctx, sdk.AccAddress(val)))
+}
+
 func TestStoreEventVoteRecord(t *testing.T) {
 	input := CreateTestEnv(t)
 	gk := input.GravityKeeper
@@ -148,10 +164,10 @@ func TestStoreEventVoteRecord(t *testing.T) {
 
 	evr := &types.EthereumEventVoteRecord{
 		Event: stcea,
-		Votes: []string{
-			ValAddrs[0].String(),
-			ValAddrs[1].String(),
-			ValAddrs[2].String(),
+		Votes: []types.EthereumEventVote{
+			{Validator: ValAddrs[0].String()},
+			{Validator: ValAddrs[1].String()},
+			{Validator: ValAddrs[2].String()},
 		},
 		Accepted: false,
 	}
@@ -168,10 +184,10 @@ func TestStoreEventVoteRecord(t *testing.T) {
 
 	evr2 := &types.EthereumEventVoteRecord{
 		Event: cctxea,
-		Votes: []string{
-			ValAddrs[2].String(),
-			ValAddrs[3].String(),
-			ValAddrs[4].String(),
+		Votes: []types.EthereumEventVote{
+			{Validator: ValAddrs[2].String()},
+			{Validator: ValAddrs[3].String()},
+			{Validator: ValAddrs[4].String()},
 		},
 	}
 
@@ -504,6 +520,77 @@ func TestKeeper_GetEthereumSignatures(t *testing.T) {
 	})
 }
 
+func TestKeeper_DeleteOutgoingTx(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+
+	ethAddr := common.HexToAddress("0x3146D2d6Eed46Afa423969f5dDC3152DfC359b09")
+	valAddr, err := sdk.ValAddressFromBech32("cosmosvaloper1jpz0ahls2chajf78nkqczdwwuqcu97w6z3plt4")
+	require.NoError(t, err)
+
+	const tokenContract = "0x1111111111111111111111111111111111111111"
+
+	{ // setup
+		for _, nonce := range []uint64{10, 11} {
+			gk.SetOutgoingTx(ctx, &types.BatchTx{
+				BatchNonce:    nonce,
+				TokenContract: tokenContract,
+			})
+			gk.SetEthereumSignature(ctx, &types.BatchTxConfirmation{
+				TokenContract:  tokenContract,
+				BatchNonce:     nonce,
+				EthereumSigner: ethAddr.Hex(),
+				Signature:      []byte("fake-signature"),
+			}, valAddr)
+		}
+	}
+
+	{ // validate
+		deleted := types.MakeBatchTxKey(common.HexToAddress(tokenContract), 10)
+		kept := types.MakeBatchTxKey(common.HexToAddress(tokenContract), 11)
+
+		gk.DeleteOutgoingTx(ctx, deleted)
+		require.Nil(t, gk.GetOutgoingTx(ctx, deleted))
+		require.Empty(t, gk.GetEthereumSignatures(ctx, deleted))
+
+		require.NotNil(t, gk.GetOutgoingTx(ctx, kept))
+		require.Len(t, gk.GetEthereumSignatures(ctx, kept), 1)
+	}
+}
+
+func TestKeeper_DeleteOutgoingTxSharedScopePrefix(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+
+	ethAddr := common.HexToAddress("0x3146D2d6Eed46Afa423969f5dDC3152DfC359b09")
+	valAddr, err := sdk.ValAddressFromBech32("cosmosvaloper1jpz0ahls2chajf78nkqczdwwuqcu97w6z3plt4")
+	require.NoError(t, err)
+
+	// the scope of the kept contract call tx starts with the scope and nonce of the deleted one
+	deletedScope := []byte("a-scope")
+	keptScope := append(append([]byte("a-scope"), sdk.Uint64ToBigEndian(1)...), []byte("b")...)
+
+	for _, scope := range [][]byte{deletedScope, keptScope} {
+		gk.SetOutgoingTx(ctx, &types.ContractCallTx{InvalidationScope: scope, InvalidationNonce: 1})
+		gk.SetEthereumSignature(ctx, &types.ContractCallTxConfirmation{
+			InvalidationScope: scope,
+			InvalidationNonce: 1,
+			EthereumSigner:    ethAddr.Hex(),
+			Signature:         []byte("fake-signature"),
+		}, valAddr)
+	}
+
+	deleted := types.MakeContractCallTxKey(deletedScope, 1)
+	kept := types.MakeContractCallTxKey(keptScope, 1)
+	require.Len(t, gk.GetEthereumSignatures(ctx, deleted), 1)
+
+	gk.DeleteOutgoingTx(ctx, deleted)
+	require.Empty(t, gk.GetEthereumSignatures(ctx, deleted))
+	require.Len(t, gk.GetEthereumSignatures(ctx, kept), 1)
+}
+
 // TODO(levi) review/ensure coverage for:
 // PaginateOutgoingTxsByType
 // GetUnbondingvalidators(unbondingVals []byte) stakingtypes.ValAddresses
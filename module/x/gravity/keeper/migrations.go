@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	removed := m.keeper.migrateEthereumSignatures(ctx)
+	m.keeper.Logger(ctx).Info("removed orphaned ethereum signatures", "count", removed)
+
+	m.keeper.initERC20Escrows(ctx)
+	m.keeper.initMissingParams(ctx)
+	m.keeper.initBadSignatureEvidence(ctx)
+	m.keeper.initLastSlashedContractCallTxBlockHeight(ctx)
+	return m.keeper.migrateEthereumEventVoteRecords(ctx)
+}
+
+// initBadSignatureEvidence indexes the checkpoints of the stored outgoing txs, which version 1 didn't, and since
+// the checkpoints of the outgoing txs it already deleted are unknown, records the latest nonces and heights at the
+// upgrade as the activation of bad signature evidence
+func (k Keeper) initBadSignatureEvidence(ctx sdk.Context) {
+	gravityID := []byte(k.getGravityID(ctx))
+	var checkpoints [][]byte
+	k.iterateOutgoingTxs(ctx, func(_ []byte, otx types.OutgoingTx) bool {
+		checkpoints = append(checkpoints, otx.GetCheckpoint(gravityID))
+		return false
+	})
+	for _, checkpoint := range checkpoints {
+		k.setPastEthereumSignatureCheckpoint(ctx, checkpoint)
+	}
+
+	k.setBadSignatureEvidenceActivation(ctx, types.BadSignatureEvidenceActivation{
+		Height:           uint64(ctx.BlockHeight()),
+		SignerSetTxNonce: k.GetLatestSignerSetTxNonce(ctx),
+		BatchNonce:       k.getLastOutgoingBatchNonce(ctx),
+		EthereumHeight:   k.GetLastObservedEthereumBlockHeight(ctx).EthereumHeight,
+	})
+}
+
+// initLastSlashedContractCallTxBlockHeight carries over the slashing progress of the contract call txs, which
+// version 1 checked along with the other outgoing txs, so they aren't checked a second time in their own window
+func (k Keeper) initLastSlashedContractCallTxBlockHeight(ctx sdk.Context) {
+	k.SetLastSlashedContractCallTxBlockHeight(ctx, k.GetLastSlashedOutgoingTxBlockHeight(ctx))
+}
+
+// migrateEthereumEventVoteRecords rewrites the event vote records of version 1, whose votes were the addresses
+// of the voters, with the current power of each voter, and sets the creation and observation heights version 1
+// didn't store to the upgrade height. The events observed before the upgrade were never checked for slashing,
+// so they're marked as checked rather than slashing everyone who missed them once the window has passed.
+func (k Keeper) migrateEthereumEventVoteRecords(ctx sdk.Context) error {
+	totalPower := k.StakingKeeper.GetLastTotalPower(ctx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumEventVoteRecordKey})
+	iter := store.Iterator(nil, nil)
+
+	var (
+		keys    [][]byte
+		records []*types.EthereumEventVoteRecord
+	)
+	for ; iter.Valid(); iter.Next() {
+		voters, err := v1EventVoteRecordVoters(iter.Value())
+		if err != nil {
+			iter.Close()
+			return sdkerrors.Wrapf(err, "event vote record %X", iter.Key())
+		}
+
+		record := &types.EthereumEventVoteRecord{}
+		k.cdc.MustUnmarshal(iter.Value(), record)
+		record.VotePower = sdk.ZeroInt()
+		for _, voter := range voters {
+			val, err := sdk.ValAddressFromBech32(voter)
+			if err != nil {
+				iter.Close()
+				return sdkerrors.Wrapf(err, "event vote record %X", iter.Key())
+			}
+			power := k.StakingKeeper.GetLastValidatorPower(ctx, val)
+			record.Votes = append(record.Votes, types.EthereumEventVote{Validator: voter, Power: power})
+			record.VotePower = record.VotePower.Add(sdk.NewInt(power))
+		}
+		record.TotalPower = totalPower
+		record.CreationHeight = uint64(ctx.BlockHeight())
+		if record.Accepted {
+			record.Height = uint64(ctx.BlockHeight())
+		}
+
+		keys = append(keys, iter.Key())
+		records = append(records, record)
+	}
+	iter.Close()
+
+	for i, key := range keys {
+		store.Set(key, k.cdc.MustMarshal(records[i]))
+	}
+	k.SetLastSlashedEventNonce(ctx, k.GetLastObservedEventNonce(ctx))
+	return nil
+}
+
+// v1EventVoteRecordVoters returns the voters of an event vote record written by version 1, which stored them
+// in field 2, a field version 2 reserves
+func v1EventVoteRecordVoters(bz []byte) (voters []string, err error) {
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		bz = bz[n:]
+
+		if num == 2 && typ == protowire.BytesType {
+			var voter []byte
+			voter, n = protowire.ConsumeBytes(bz)
+			voters = append(voters, string(voter))
+		} else {
+			n = protowire.ConsumeFieldValue(num, typ, bz)
+		}
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		bz = bz[n:]
+	}
+	return voters, nil
+}
+
+// initMissingParams sets the params that were added after version 1 to their default values, reading the params
+// panics until every registered one is set
+func (k Keeper) initMissingParams(ctx sdk.Context) {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !k.paramSpace.Has(ctx, pair.Key) {
+			k.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
+
+// initERC20Escrows sets the escrow of every ethereum originated ERC20 to the supply of its vouchers and the
+// sends to ethereum of it that weren't executed yet, which is what the bridge contract holds for them
+func (k Keeper) initERC20Escrows(ctx sdk.Context) {
+	for contract, amount := range k.expectedERC20Escrows(ctx) {
+		k.setERC20Escrow(ctx, types.NewSDKIntERC20Token(amount, contract))
+	}
+}
+
+// migrateEthereumSignatures length prefixes the store index in the ethereum signature keys, and removes the
+// signatures left behind by outgoing txs that were deleted before the deletion cascaded to their signatures.
+// It returns how many were removed
+func (k Keeper) migrateEthereumSignatures(ctx sdk.Context) int {
+	// version 1 keys are the store index of their outgoing tx followed by the validator address, and the
+	// store indexes of contract call txs vary in length, so match them against every stored index, the
+	// longest first since a scope can start with the store index of another contract call tx
+	storeIndexes := make(map[string]bool)
+	lengths := make(map[int]bool)
+	k.iterateOutgoingTxs(ctx, func(key []byte, _ types.OutgoingTx) bool {
+		storeIndexes[string(key)] = true
+		lengths[len(key)] = true
+		return false
+	})
+	sortedLengths := make([]int, 0, len(lengths))
+	for length := range lengths {
+		sortedLengths = append(sortedLengths, length)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(sortedLengths)))
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumSignatureKey})
+	iter := store.Iterator(nil, nil)
+
+	var keys, values [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+		values = append(values, iter.Value())
+	}
+	iter.Close()
+
+	orphaned := 0
+	for i, key := range keys {
+		store.Delete(key)
+
+		found := false
+		for _, length := range sortedLengths {
+			if length < len(key) && storeIndexes[string(key[:length])] {
+				ctx.KVStore(k.storeKey).Set(types.MakeEthereumSignatureKey(key[:length], key[length:]), values[i])
+				found = true
+				break
+			}
+		}
+		if !found {
+			orphaned++
+		}
+	}
+	return orphaned
+}
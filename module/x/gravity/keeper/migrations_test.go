@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/decoder"
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	ethAddr := common.HexToAddress("0x3146D2d6Eed46Afa423969f5dDC3152DfC359b09")
+	valAddr, err := sdk.ValAddressFromBech32("cosmosvaloper1jpz0ahls2chajf78nkqczdwwuqcu97w6z3plt4")
+	require.NoError(t, err)
+
+	// signatures written by version 1 didn't length prefix the store index, and some outlived their outgoing txs
+	setSignature := func(confirmation types.EthereumTxConfirmation) []byte {
+		key := append(append([]byte{types.EthereumSignatureKey}, confirmation.GetStoreIndex()...), valAddr.Bytes()...)
+		ctx.KVStore(k.storeKey).Set(key, []byte("fake-signature"))
+		return confirmation.GetStoreIndex()
+	}
+
+	// the version 1 signature keys of the second contract call tx start with the store index of the first
+	sharedScope := append(append([]byte("a-scope"), sdk.Uint64ToBigEndian(1)...), []byte("b")...)
+	k.SetOutgoingTx(ctx, &types.SignerSetTx{Nonce: 1})
+	k.SetOutgoingTx(ctx, &types.ContractCallTx{InvalidationScope: []byte("a-scope"), InvalidationNonce: 1})
+	k.SetOutgoingTx(ctx, &types.ContractCallTx{InvalidationScope: sharedScope, InvalidationNonce: 1})
+
+	kept := [][]byte{
+		setSignature(&types.SignerSetTxConfirmation{SignerSetNonce: 1, EthereumSigner: ethAddr.Hex()}),
+		setSignature(&types.ContractCallTxConfirmation{InvalidationScope: []byte("a-scope"), InvalidationNonce: 1, EthereumSigner: ethAddr.Hex()}),
+		setSignature(&types.ContractCallTxConfirmation{InvalidationScope: sharedScope, InvalidationNonce: 1, EthereumSigner: ethAddr.Hex()}),
+	}
+	orphaned := [][]byte{
+		setSignature(&types.SignerSetTxConfirmation{SignerSetNonce: 2, EthereumSigner: ethAddr.Hex()}),
+		setSignature(&types.BatchTxConfirmation{TokenContract: TokenContractAddrs[0], BatchNonce: 1, EthereumSigner: ethAddr.Hex()}),
+		setSignature(&types.ContractCallTxConfirmation{InvalidationScope: []byte("another-scope"), InvalidationNonce: 1, EthereumSigner: ethAddr.Hex()}),
+	}
+
+	// vouchers minted by version 1 weren't escrowed
+	contract := common.HexToAddress(TokenContractAddrs[0])
+	vouchers := types.NewERC20Token(700, contract.Hex()).GravityCoin()
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, AccAddrs[0], sdk.Coins{vouchers}))
+	k.setUnbatchedSendToEthereum(ctx, &types.SendToEthereum{
+		Id:                1,
+		Sender:            AccAddrs[0].String(),
+		EthereumRecipient: ethAddr.Hex(),
+		Erc20Token:        types.NewERC20Token(200, contract.Hex()),
+		Erc20Fee:          types.NewERC20Token(100, contract.Hex()),
+	})
+
+	// version 1 checked the contract call txs up to height 10 for slashing along with the other outgoing txs
+	k.SetOutgoingTx(ctx, &types.ContractCallTx{InvalidationScope: []byte("checked-scope"), InvalidationNonce: 1, Height: 10})
+	k.SetOutgoingTx(ctx, &types.ContractCallTx{InvalidationScope: []byte("unchecked-scope"), InvalidationNonce: 1, Height: 11})
+	k.SetLastSlashedOutgoingTxBlockHeight(ctx, 10)
+
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+
+	// only the contract call txs version 1 didn't check are checked in their own window
+	require.Equal(t, uint64(10), k.GetLastSlashedContractCallTxBlockHeight(ctx))
+	unslashed := k.GetUnSlashedContractCallTxs(ctx, 100)
+	require.Len(t, unslashed, 1)
+	require.Equal(t, "unchecked-scope", string(unslashed[0].InvalidationScope))
+
+	for _, storeIndex := range kept {
+		require.Equal(t, map[string][]byte{valAddr.String(): []byte("fake-signature")}, k.GetEthereumSignatures(ctx, storeIndex))
+	}
+	for _, storeIndex := range orphaned {
+		require.Empty(t, k.GetEthereumSignatures(ctx, storeIndex))
+	}
+
+	require.Equal(t, sdk.NewInt(1000), k.GetERC20Escrow(ctx, contract))
+	_, broken := EthereumOriginatedSupplyInvariant(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestMigrate1to2Fixture(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	// the fixture has two signatures of every outgoing tx, and version 1 kept those of the observed signer
+	// set and of the executed batch after they were deleted
+	LoadV1StoreFixture(t, ctx, k.storeKey, input.BankKeeper, "testdata/v1_store.json")
+	contract := common.HexToAddress(TokenContractAddrs[0])
+
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+
+	// every entry has the version 2 layout
+	prefixes := make(map[string]int)
+	iter := ctx.KVStore(k.storeKey).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		entry, err := decoder.Decode(k.cdc, iter.Key(), iter.Value())
+		require.NoError(t, err)
+		prefixes[entry.Prefix]++
+	}
+	iter.Close()
+	require.Equal(t, 3, prefixes["OutgoingTx"])
+	require.Equal(t, 6, prefixes["EthereumSignature"])
+	require.Equal(t, 1, prefixes["ERC20Escrow"])
+	require.Equal(t, 4, prefixes["EthereumEventVoteRecord"])
+	require.Equal(t, 3, prefixes["PastEthereumSignatureCheckpoint"])
+
+	require.Empty(t, k.GetEthereumSignatures(ctx, types.MakeSignerSetTxKey(1)))
+	require.Empty(t, k.GetEthereumSignatures(ctx, types.MakeBatchTxKey(contract, 1)))
+	require.Len(t, k.GetEthereumSignatures(ctx, types.MakeSignerSetTxKey(2)), 2)
+	require.Len(t, k.GetEthereumSignatures(ctx, types.MakeBatchTxKey(contract, 2)), 2)
+
+	// the checkpoints of the stored outgoing txs are indexed, the deleted ones are covered by the activation
+	k.iterateOutgoingTxs(ctx, func(_ []byte, otx types.OutgoingTx) bool {
+		require.True(t, k.isPastEthereumSignatureCheckpoint(ctx, otx.GetCheckpoint([]byte(k.getGravityID(ctx)))))
+		return false
+	})
+	require.Equal(t, &types.BadSignatureEvidenceActivation{
+		Height:           uint64(ctx.BlockHeight()),
+		SignerSetTxNonce: 2,
+		BatchNonce:       2,
+		EthereumHeight:   k.GetLastObservedEthereumBlockHeight(ctx).EthereumHeight,
+	}, k.getBadSignatureEvidenceActivation(ctx))
+
+	// the bridge contract holds the 1000 deposited but the 103 of the executed batch
+	require.Equal(t, sdk.NewInt(897), k.GetERC20Escrow(ctx, contract))
+	msg, broken := AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+
+	// the fixture has accepted records at nonces 1 to 3 and a pending one at nonce 4, their votes kept
+	// their voters, and the accepted ones count as observed at the upgrade
+	height := uint64(ctx.BlockHeight())
+	records := k.GetEthereumEventVoteRecordMapping(ctx)
+	require.Len(t, records, 4)
+	for nonce, votes := range map[uint64]int{1: 4, 2: 5, 3: 4, 4: 2} {
+		require.Len(t, records[nonce], 1)
+		require.Len(t, records[nonce][0].Votes, votes)
+		require.Equal(t, nonce < 4, records[nonce][0].Accepted)
+		require.Equal(t, height, records[nonce][0].CreationHeight)
+		require.False(t, records[nonce][0].VotePower.IsNil())
+		require.False(t, records[nonce][0].TotalPower.IsNil())
+	}
+	require.Equal(t, height, records[1][0].Height)
+	require.Equal(t, uint64(3), k.GetLastSlashedEventNonce(ctx))
+
+	// the fixture validators aren't bonded, so the pending record stays pending
+	k.TallyEthereumEventVoteRecords(ctx)
+	require.Equal(t, uint64(3), k.GetLastObservedEventNonce(ctx))
+
+	// the records observed before the upgrade are kept for the window that starts at the upgrade
+	k.PruneEthereumEventVoteRecords(ctx, height)
+	require.Len(t, k.GetEthereumEventVoteRecordMapping(ctx), 4)
+	k.PruneEthereumEventVoteRecords(ctx, height+1)
+	require.Len(t, k.GetEthereumEventVoteRecordMapping(ctx), 2)
+	require.Equal(t, height, k.GetEthereumEventSummary(ctx, 1).Height)
+}
+
+func TestMigrate1to2EventVoteRecords(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	newEvent := func(nonce uint64) *types.SendToCosmosEvent {
+		return &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  TokenContractAddrs[0],
+			Amount:         sdk.NewInt(12),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[0].String(),
+		}
+	}
+
+	// version 1 observed the event at nonce 1, and three of the five validators voted for the one at nonce 2
+	k.setLastObservedEventNonce(ctx, 1)
+	setV1EventVoteRecord(t, ctx, k, newEvent(1), ValAddrs, true)
+	setV1EventVoteRecord(t, ctx, k, newEvent(2), ValAddrs[:3], false)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+
+	power := input.StakingKeeper.GetLastValidatorPower(ctx, ValAddrs[0])
+	record := k.GetEthereumEventVoteRecord(ctx, 2, newEvent(2).Hash())
+	require.Equal(t, types.EthereumEventVote{Validator: ValAddrs[0].String(), Power: power}, record.Votes[0])
+	require.Equal(t, sdk.NewInt(3*power), record.VotePower)
+	require.Equal(t, input.StakingKeeper.GetLastTotalPower(ctx), record.TotalPower)
+	require.Equal(t, uint64(ctx.BlockHeight()), record.CreationHeight)
+
+	// the events observed before the upgrade aren't slashed for
+	require.Equal(t, uint64(1), k.GetLastSlashedEventNonce(ctx))
+
+	k.TallyEthereumEventVoteRecords(ctx)
+	require.False(t, k.GetEthereumEventVoteRecord(ctx, 2, newEvent(2).Hash()).Accepted)
+
+	// the votes cast before the upgrade count with the ones cast after it
+	k.setLastEventNonceByValidator(ctx, ValAddrs[3], 1)
+	_, err := k.recordEventVote(ctx, newEvent(2), ValAddrs[3])
+	require.NoError(t, err)
+	k.TallyEthereumEventVoteRecords(ctx)
+	require.True(t, k.GetEthereumEventVoteRecord(ctx, 2, newEvent(2).Hash()).Accepted)
+}
+
+// setV1EventVoteRecord stores an event vote record the way version 1 encoded it, with the addresses of the
+// voters in field 2
+func setV1EventVoteRecord(t *testing.T, ctx sdk.Context, k Keeper, event types.EthereumEvent, voters []sdk.ValAddress, accepted bool) {
+	any, err := types.PackEvent(event)
+	require.NoError(t, err)
+	anyBz, err := any.Marshal()
+	require.NoError(t, err)
+
+	bz := protowire.AppendTag(nil, 1, protowire.BytesType)
+	bz = protowire.AppendBytes(bz, anyBz)
+	for _, voter := range voters {
+		bz = protowire.AppendTag(bz, 2, protowire.BytesType)
+		bz = protowire.AppendString(bz, voter.String())
+	}
+	if accepted {
+		bz = protowire.AppendTag(bz, 3, protowire.VarintType)
+		bz = protowire.AppendVarint(bz, 1)
+	}
+	ctx.KVStore(k.storeKey).Set(types.MakeEthereumEventVoteRecordKey(event.GetEventNonce(), event.Hash()), bz)
+}
@@ -201,12 +201,20 @@ func (k msgServer) SubmitEthereumEvent(c context.Context, msg *types.MsgSubmitEt
 // SendToEthereum handles MsgSendToEthereum
 func (k msgServer) SendToEthereum(c context.Context, msg *types.MsgSendToEthereum) (*types.MsgSendToEthereumResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	if k.IsBridgeHalted(ctx) {
+		return nil, types.ErrBridgeHalted
+	}
+
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, err
 	}
 
-	txID, err := k.createSendToEthereum(ctx, sender, msg.EthereumRecipient, msg.Amount, msg.BridgeFee)
+	if _, tokenContract, err := k.DenomToERC20Lookup(ctx, msg.Amount.Denom); err == nil && k.IsTokenPaused(ctx, tokenContract) {
+		return nil, sdkerrors.Wrapf(types.ErrBridgePaused, "token %s", tokenContract.Hex())
+	}
+
+	txID, err := k.createSendToEthereum(ctx, sender, msg.EthereumRecipient, msg.Amount, msg.BridgeFee, msg.ExpiresAtHeight)
 	if err != nil {
 		return nil, err
 	}
@@ -230,10 +238,53 @@ func (k msgServer) SendToEthereum(c context.Context, msg *types.MsgSendToEthereu
 	return &types.MsgSendToEthereumResponse{Id: txID}, nil
 }
 
+// SendToEthereumMulti handles MsgSendToEthereumMulti
+func (k msgServer) SendToEthereumMulti(c context.Context, msg *types.MsgSendToEthereumMulti) (*types.MsgSendToEthereumMultiResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if k.IsBridgeHalted(ctx) {
+		return nil, types.ErrBridgeHalted
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msg.Recipients) != 0 {
+		if _, tokenContract, err := k.DenomToERC20Lookup(ctx, msg.Recipients[0].Amount.Denom); err == nil && k.IsTokenPaused(ctx, tokenContract) {
+			return nil, sdkerrors.Wrapf(types.ErrBridgePaused, "token %s", tokenContract.Hex())
+		}
+	}
+
+	txIDs, err := k.createSendToEthereums(ctx, sender, msg.Recipients, msg.ExpiresAtHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	messageAttributes := []sdk.Attribute{sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type())}
+	for _, txID := range txIDs {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeBridgeWithdrawalReceived,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, strconv.Itoa(int(txID))),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(txID)),
+		))
+		messageAttributes = append(messageAttributes, sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(txID)))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(sdk.EventTypeMessage, messageAttributes...))
+
+	return &types.MsgSendToEthereumMultiResponse{Ids: txIDs}, nil
+}
+
 // RequestBatchTx handles MsgRequestBatchTx
 func (k msgServer) RequestBatchTx(c context.Context, msg *types.MsgRequestBatchTx) (*types.MsgRequestBatchTxResponse, error) {
 	// TODO: limit this to only orchestrators and validators?
 	ctx := sdk.UnwrapSDKContext(c)
+	if k.IsBridgeHalted(ctx) {
+		return nil, types.ErrBridgeHalted
+	}
 
 	// Check if the denom is a gravity coin, if not, check if there is a deployed ERC20 representing it.
 	// If not, error out
@@ -241,8 +292,11 @@ func (k msgServer) RequestBatchTx(c context.Context, msg *types.MsgRequestBatchT
 	if err != nil {
 		return nil, err
 	}
+	if k.IsTokenPaused(ctx, tokenContract) {
+		return nil, sdkerrors.Wrapf(types.ErrBridgePaused, "token %s", tokenContract.Hex())
+	}
 
-	batchID := k.BuildBatchTx(ctx, tokenContract, BatchTxSize)
+	batchID := k.BuildBatchTx(ctx, tokenContract, k.BatchCreationPolicy.MaxBatchSize(ctx, tokenContract))
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -281,6 +335,28 @@ func (k msgServer) CancelSendToEthereum(c context.Context, msg *types.MsgCancelS
 	return &types.MsgCancelSendToEthereumResponse{}, nil
 }
 
+// IncreaseSendToEthereumFee handles MsgIncreaseSendToEthereumFee
+func (k msgServer) IncreaseSendToEthereumFee(c context.Context, msg *types.MsgIncreaseSendToEthereumFee) (*types.MsgIncreaseSendToEthereumFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if k.IsBridgeHalted(ctx) {
+		return nil, types.ErrBridgeHalted
+	}
+
+	if err := k.Keeper.increaseSendToEthereumFee(ctx, msg.Id, msg.Sender, msg.AdditionalFee); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(msg.Id)),
+		),
+	)
+
+	return &types.MsgIncreaseSendToEthereumFeeResponse{}, nil
+}
+
 // getSignerValidator takes an sdk.AccAddress that represents either a validator or orchestrator address and returns
 // the assoicated validator address
 func (k Keeper) getSignerValidator(ctx sdk.Context, signerString string) (sdk.ValAddress, error) {
@@ -303,3 +379,55 @@ func (k Keeper) getSignerValidator(ctx sdk.Context, signerString string) (sdk.Va
 
 	return validatorI.GetOperator(), nil
 }
+
+// SubmitBadSignatureEvidence handles MsgSubmitBadSignatureEvidence
+func (k msgServer) SubmitBadSignatureEvidence(c context.Context, msg *types.MsgSubmitBadSignatureEvidence) (*types.MsgSubmitBadSignatureEvidenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	subject, err := types.UnpackOutgoingTx(msg.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	vals, err := k.checkBadSignatureEvidence(ctx, subject, msg.Signature)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "bad signature evidence")
+	}
+
+	for _, val := range vals {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBadSignatureEvidence,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyOutgoingTXID, string(subject.GetStoreIndex())),
+				sdk.NewAttribute(types.AttributeKeyValidatorAddr, val.String()),
+			),
+		)
+	}
+
+	return &types.MsgSubmitBadSignatureEvidenceResponse{}, nil
+}
+
+// CancelPendingSendToEthereum handles MsgCancelPendingSendToEthereum
+func (k msgServer) CancelPendingSendToEthereum(c context.Context, msg *types.MsgCancelPendingSendToEthereum) (*types.MsgCancelPendingSendToEthereumResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	guardian := k.GetParams(ctx).WithdrawalGuardian
+	if guardian == "" || guardian != msg.Signer {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the withdrawal guardian", msg.Signer)
+	}
+
+	if err := k.Keeper.CancelPendingSendToEthereum(ctx, msg.Id); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(msg.Id)),
+		),
+	)
+
+	return &types.MsgCancelPendingSendToEthereumResponse{}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethCrypto "github.com/ethereum/go-ethereum/crypto"
@@ -138,6 +139,65 @@ func TestMsgServer_SendToEthereum(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMsgServer_SendToEthereumMulti(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		sender, _     = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		voucher       = types.NewERC20Token(0, tokenContract.Hex()).GravityCoin().Denom
+		receivers     = []common.Address{
+			common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"),
+			common.HexToAddress("0x2a24af0501A534Fca004EE1bD667B783f205A546"),
+			common.HexToAddress("0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255"),
+		}
+	)
+	require.NoError(t, env.AddBalanceToBank(ctx, sender, sdk.Coins{sdk.NewInt64Coin(voucher, 10000)}))
+	env.AddSendToEthTxsToPool(t, ctx, tokenContract, sender, receivers[0], 1)
+
+	msgServer := NewMsgServerImpl(gk)
+	msg := types.NewMsgSendToEthereumMulti(sender, []types.SendToEthereumRecipient{
+		{EthereumRecipient: receivers[0].Hex(), Amount: sdk.NewInt64Coin(voucher, 1000), BridgeFee: sdk.NewInt64Coin(voucher, 10)},
+		{EthereumRecipient: receivers[1].Hex(), Amount: sdk.NewInt64Coin(voucher, 2000), BridgeFee: sdk.NewInt64Coin(voucher, 30)},
+		{EthereumRecipient: receivers[2].Hex(), Amount: sdk.NewInt64Coin(voucher, 3000), BridgeFee: sdk.NewInt64Coin(voucher, 20)},
+	})
+	supply := env.BankKeeper.GetSupply(ctx, voucher).Amount
+
+	res, err := msgServer.SendToEthereumMulti(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 3, 4}, res.Ids)
+
+	var got []*types.SendToEthereum
+	gk.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+		got = append(got, ste)
+		return false
+	})
+	require.Equal(t, []*types.SendToEthereum{
+		types.NewSendToEthereumTx(3, tokenContract, sender, receivers[1], 2000, 30),
+		types.NewSendToEthereumTx(4, tokenContract, sender, receivers[2], 3000, 20),
+		types.NewSendToEthereumTx(2, tokenContract, sender, receivers[0], 1000, 10),
+		types.NewSendToEthereumTx(1, tokenContract, sender, receivers[0], 100, 1),
+	}, got)
+	require.Equal(t, sdk.NewInt(10000-101-6060), env.BankKeeper.GetBalance(ctx, sender, voucher).Amount)
+	require.Equal(t, supply.SubRaw(6060), env.BankKeeper.GetSupply(ctx, voucher).Amount)
+
+	withdrawals := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeBridgeWithdrawalReceived {
+			withdrawals++
+		}
+	}
+	require.Equal(t, 3, withdrawals)
+
+	// a recipient of another denom fails the whole message
+	msg.Recipients[2].Amount = sdk.NewInt64Coin("stake", 3000)
+	_, err = msgServer.SendToEthereumMulti(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+	require.Equal(t, uint64(4), gk.getLastSendToEthereumID(ctx))
+}
+
 func TestMsgServer_CancelSendToEthereum(t *testing.T) {
 	ethPrivKey, err := ethCrypto.GenerateKey()
 	require.NoError(t, err)
@@ -399,3 +459,159 @@ func TestEthVerify(t *testing.T) {
 	gorcSig := "0xbda7037e448ca07ac91f5f386b72df37b6bbacf102b2c8f5acb58b5e053d68d96875ce9e442433bea55ac083230f492670ca2c07a8303c332dca06b1c0758c661b"
 	require.Equal(t, hexutil.Encode(sig), gorcSig)
 }
+
+func TestMsgServer_SubmitBadSignatureEvidence(t *testing.T) {
+	ethPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+	msgServer := NewMsgServerImpl(gk)
+	gravityID := []byte(gk.getGravityID(ctx))
+
+	ethAddr := crypto.PubkeyToAddress(ethPrivKey.PublicKey)
+	gk.setValidatorEthereumAddress(ctx, ValAddrs[0], ethAddr)
+
+	submit := func(otx types.OutgoingTx) error {
+		signature, err := types.NewEthereumSignature(otx.GetCheckpoint(gravityID), ethPrivKey)
+		require.NoError(t, err)
+		subject, err := types.PackOutgoingTx(otx)
+		require.NoError(t, err)
+		_, err = msgServer.SubmitBadSignatureEvidence(sdk.WrapSDKContext(ctx), &types.MsgSubmitBadSignatureEvidence{
+			Subject:   subject,
+			Signature: signature,
+			Signer:    AccAddrs[1].String(),
+		})
+		return err
+	}
+
+	// signatures over outgoing txs produced by the chain are not evidence
+	signerSetTx := gk.CreateSignerSetTx(ctx)
+	require.Error(t, submit(signerSetTx))
+
+	// even after the outgoing tx has been pruned
+	gk.DeleteOutgoingTx(ctx, signerSetTx.GetStoreIndex())
+	require.Error(t, submit(signerSetTx))
+	require.False(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+
+	fakeBatchTx := &types.BatchTx{
+		BatchNonce:    1,
+		Timeout:       1000,
+		Transactions:  []*types.SendToEthereum{},
+		TokenContract: TokenContractAddrs[0],
+		Height:        uint64(ctx.BlockHeight()),
+	}
+	tokensBefore := input.StakingKeeper.Validator(ctx, ValAddrs[0]).GetTokens()
+	require.NoError(t, submit(fakeBatchTx))
+
+	val := input.StakingKeeper.Validator(ctx, ValAddrs[0])
+	consAddr, err := val.GetConsAddr()
+	require.NoError(t, err)
+	require.True(t, val.IsJailed())
+	require.True(t, input.SlashingKeeper.IsTombstoned(ctx, consAddr))
+	require.True(t, val.GetTokens().LT(tokensBefore))
+
+	// the same evidence can't be used twice
+	require.Error(t, submit(fakeBatchTx))
+}
+
+func TestMsgServer_SubmitBadSignatureEvidenceActivation(t *testing.T) {
+	ethPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+	msgServer := NewMsgServerImpl(gk)
+	gravityID := []byte(gk.getGravityID(ctx))
+
+	ethAddr := crypto.PubkeyToAddress(ethPrivKey.PublicKey)
+	gk.setValidatorEthereumAddress(ctx, ValAddrs[0], ethAddr)
+
+	submit := func(otx types.OutgoingTx) error {
+		signature, err := types.NewEthereumSignature(otx.GetCheckpoint(gravityID), ethPrivKey)
+		require.NoError(t, err)
+		subject, err := types.PackOutgoingTx(otx)
+		require.NoError(t, err)
+		_, err = msgServer.SubmitBadSignatureEvidence(sdk.WrapSDKContext(ctx), &types.MsgSubmitBadSignatureEvidence{
+			Subject:   subject,
+			Signature: signature,
+			Signer:    AccAddrs[1].String(),
+		})
+		return err
+	}
+
+	// the chain was upgraded at height 10 and ethereum height 100 after producing signer set txs up to nonce 5
+	// and batch txs up to nonce 3, whose checkpoints weren't indexed
+	gk.setBadSignatureEvidenceActivation(ctx, types.BadSignatureEvidenceActivation{
+		Height:           10,
+		SignerSetTxNonce: 5,
+		BatchNonce:       3,
+		EthereumHeight:   100,
+	})
+
+	require.Error(t, submit(&types.SignerSetTx{Nonce: 5}))
+	require.Error(t, submit(&types.BatchTx{BatchNonce: 3, TokenContract: TokenContractAddrs[0]}))
+	require.Error(t, submit(&types.ContractCallTx{InvalidationScope: []byte("a-scope"), InvalidationNonce: 1, Height: 10, Timeout: 1000}))
+	// the height of a contract call isn't signed, so one that timed out before the upgrade is refused whatever it claims
+	require.Error(t, submit(&types.ContractCallTx{InvalidationScope: []byte("a-scope"), InvalidationNonce: 1, Height: 11, Timeout: 100}))
+	require.False(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+
+	// evidence against the outgoing txs after the activation is accepted
+	upgraded := ctx
+	ctx, _ = upgraded.CacheContext()
+	require.NoError(t, submit(&types.BatchTx{BatchNonce: 4, TokenContract: TokenContractAddrs[0]}))
+	require.True(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+
+	ctx, _ = upgraded.CacheContext()
+	require.NoError(t, submit(&types.ContractCallTx{InvalidationScope: []byte("a-scope"), InvalidationNonce: 1, Height: 11, Timeout: 1000}))
+	require.True(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+}
+
+func TestMsgServer_SubmitBadSignatureEvidenceSharedEthereumAddress(t *testing.T) {
+	ethPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+	msgServer := NewMsgServerImpl(gk)
+	gravityID := []byte(gk.getGravityID(ctx))
+
+	// two validators map to the same ethereum address
+	ethAddr := crypto.PubkeyToAddress(ethPrivKey.PublicKey)
+	gk.setValidatorEthereumAddress(ctx, ValAddrs[0], ethAddr)
+	gk.setValidatorEthereumAddress(ctx, ValAddrs[1], ethAddr)
+
+	fakeBatchTx := &types.BatchTx{BatchNonce: 1, Timeout: 1000, TokenContract: TokenContractAddrs[0]}
+	signature, err := types.NewEthereumSignature(fakeBatchTx.GetCheckpoint(gravityID), ethPrivKey)
+	require.NoError(t, err)
+	subject, err := types.PackOutgoingTx(fakeBatchTx)
+	require.NoError(t, err)
+	_, err = msgServer.SubmitBadSignatureEvidence(sdk.WrapSDKContext(ctx), &types.MsgSubmitBadSignatureEvidence{
+		Subject:   subject,
+		Signature: signature,
+		Signer:    AccAddrs[2].String(),
+	})
+	require.NoError(t, err)
+
+	// both are punished and named in an event
+	var named []string
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type != types.EventTypeBadSignatureEvidence {
+			continue
+		}
+		for _, attr := range e.Attributes {
+			if string(attr.Key) == types.AttributeKeyValidatorAddr {
+				named = append(named, string(attr.Value))
+			}
+		}
+	}
+	for _, val := range ValAddrs[:2] {
+		validator := input.StakingKeeper.Validator(ctx, val)
+		consAddr, err := validator.GetConsAddr()
+		require.NoError(t, err)
+		require.True(t, validator.IsJailed())
+		require.True(t, input.SlashingKeeper.IsTombstoned(ctx, consAddr))
+		require.Contains(t, named, val.String())
+	}
+	require.False(t, input.StakingKeeper.Validator(ctx, ValAddrs[2]).IsJailed())
+}
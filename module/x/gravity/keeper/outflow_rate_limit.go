@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// OutflowRateLimitBuckets is the number of buckets the window of an outflow rate limit is tracked in
+const OutflowRateLimitBuckets = 10
+
+// GetOutflowRateLimit returns the outflow rate limit of a denom
+func (k Keeper) GetOutflowRateLimit(ctx sdk.Context, denom string) (types.OutflowRateLimit, bool) {
+	for _, limit := range k.GetParams(ctx).OutflowRateLimits {
+		if limit.Denom == denom {
+			return limit, true
+		}
+	}
+	return types.OutflowRateLimit{}, false
+}
+
+// GetOutflowUsage returns the amount of the denom of the rate limit sent to ethereum in its window
+func (k Keeper) GetOutflowUsage(ctx sdk.Context, limit types.OutflowRateLimit) sdk.Int {
+	used := sdk.ZeroInt()
+	k.iterateOutflowBuckets(ctx, limit.Denom, func(start uint64, amount sdk.Int) bool {
+		if outflowBucketInWindow(ctx, limit, start) {
+			used = used.Add(amount)
+		}
+		return false
+	})
+	return used
+}
+
+// recordOutflow adds the amount to the current bucket of its denom, failing if it would exceed the
+// rate limit of the denom. Buckets that left the window are pruned.
+func (k Keeper) recordOutflow(ctx sdk.Context, amount sdk.Coin) error {
+	limit, found := k.GetOutflowRateLimit(ctx, amount.Denom)
+	if !found {
+		return nil
+	}
+
+	used := k.GetOutflowUsage(ctx, limit)
+	if remaining := limit.MaxAmount.Sub(used); amount.Amount.GT(remaining) {
+		return sdkerrors.Wrapf(
+			types.ErrOutflowRateLimit,
+			"%s requested, %s%s remaining in the last %d blocks", amount, remaining, amount.Denom, limit.Window,
+		)
+	}
+
+	var expired []uint64
+	k.iterateOutflowBuckets(ctx, limit.Denom, func(start uint64, _ sdk.Int) bool {
+		if !outflowBucketInWindow(ctx, limit, start) {
+			expired = append(expired, start)
+		}
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, start := range expired {
+		store.Delete(types.MakeOutflowBucketKey(limit.Denom, start))
+	}
+
+	height := uint64(ctx.BlockHeight())
+	start := height - height%outflowBucketSize(limit)
+	key := types.MakeOutflowBucketKey(limit.Denom, start)
+	bucket := amount.Amount
+	if bz := store.Get(key); bz != nil {
+		var stored sdk.Int
+		if err := stored.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+		bucket = bucket.Add(stored)
+	}
+
+	k.setOutflowBucket(ctx, types.OutflowBucket{Denom: limit.Denom, StartHeight: start, Amount: bucket})
+
+	return nil
+}
+
+func (k Keeper) setOutflowBucket(ctx sdk.Context, bucket types.OutflowBucket) {
+	bz, err := bucket.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.MakeOutflowBucketKey(bucket.Denom, bucket.StartHeight), bz)
+}
+
+// iterateAllOutflowBuckets iterates through the buckets of every denom, including the denoms that no
+// longer have a rate limit
+func (k Keeper) iterateAllOutflowBuckets(ctx sdk.Context, cb func(types.OutflowBucket) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.OutflowBucketKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// keys are the length prefixed denom followed by the bucket start height
+		key := iter.Key()
+		denomLen := int(key[0])
+		bucket := types.OutflowBucket{
+			Denom:       string(key[1 : 1+denomLen]),
+			StartHeight: binary.BigEndian.Uint64(key[1+denomLen:]),
+		}
+		if err := bucket.Amount.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+		if cb(bucket) {
+			break
+		}
+	}
+}
+
+func (k Keeper) iterateOutflowBuckets(ctx sdk.Context, denom string, cb func(start uint64, amount sdk.Int) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeOutflowBucketPrefix(denom)).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+		if cb(binary.BigEndian.Uint64(iter.Key()), amount) {
+			break
+		}
+	}
+}
+
+// outflowBucketSize returns the number of blocks tracked per bucket for the rate limit
+func outflowBucketSize(limit types.OutflowRateLimit) uint64 {
+	return (limit.Window + OutflowRateLimitBuckets - 1) / OutflowRateLimitBuckets
+}
+
+// outflowBucketInWindow returns true if any block of the bucket starting at start is in the window
+// of the rate limit, so a bucket only leaves the window once all its blocks did
+func outflowBucketInWindow(ctx sdk.Context, limit types.OutflowRateLimit, start uint64) bool {
+	height := uint64(ctx.BlockHeight())
+	if height < limit.Window {
+		return true
+	}
+	// the window holds the blocks after height - window
+	return start+outflowBucketSize(limit)-1 > height-limit.Window
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+func TestOutflowRateLimit(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context.WithBlockHeight(100)
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		denom               = types.NewERC20Token(0, myTokenContractAddr.Hex()).GravityCoin().Denom
+	)
+
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(denom, 99999)}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	params := k.GetParams(ctx)
+	params.OutflowRateLimits = []types.OutflowRateLimit{{Denom: denom, MaxAmount: sdk.NewInt(100), Window: 20}}
+	k.SetParams(ctx, params)
+
+	send := func(ctx sdk.Context, amount, fee int64) error {
+		_, err := k.createSendToEthereum(ctx, mySender, myReceiver.Hex(), sdk.NewInt64Coin(denom, amount), sdk.NewInt64Coin(denom, fee), 0)
+		return err
+	}
+
+	// fees count towards the limit
+	require.NoError(t, send(ctx, 50, 10))
+	require.ErrorIs(t, send(ctx, 40, 1), types.ErrOutflowRateLimit)
+	require.NoError(t, send(ctx.WithBlockHeight(105), 39, 1))
+
+	res, err := k.OutflowRateLimitUsage(sdk.WrapSDKContext(ctx.WithBlockHeight(105)), &types.OutflowRateLimitUsageRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(100), res.Used)
+	require.True(t, res.Remaining.IsZero())
+
+	// the first sends leave the window, the later ones don't yet
+	ctx = ctx.WithBlockHeight(121)
+	require.Equal(t, sdk.NewInt(40), k.GetOutflowUsage(ctx, params.OutflowRateLimits[0]))
+	require.ErrorIs(t, send(ctx, 61, 0), types.ErrOutflowRateLimit)
+	require.NoError(t, send(ctx, 60, 0))
+
+	// the expired bucket was pruned
+	var buckets int
+	k.iterateOutflowBuckets(ctx, denom, func(uint64, sdk.Int) bool {
+		buckets++
+		return false
+	})
+	require.Equal(t, 2, buckets)
+
+	// denoms without a rate limit are not limited
+	_, err = k.OutflowRateLimitUsage(sdk.WrapSDKContext(ctx), &types.OutflowRateLimitUsageRequest{Denom: "stake"})
+	require.Error(t, err)
+}
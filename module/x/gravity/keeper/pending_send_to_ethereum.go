@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// isLargeWithdrawal returns true if the amount is above the large withdrawal threshold of its denom
+func (k Keeper) isLargeWithdrawal(ctx sdk.Context, amount sdk.Coin) bool {
+	for _, threshold := range k.GetParams(ctx).LargeWithdrawalThresholds {
+		if threshold.Denom == amount.Denom {
+			return amount.Amount.GT(threshold.Amount)
+		}
+	}
+	return false
+}
+
+// holdSendToEthereum holds a large send to ethereum until the release height
+func (k Keeper) holdSendToEthereum(ctx sdk.Context, send *types.SendToEthereum, releaseHeight uint64) {
+	k.setPendingSendToEthereum(ctx, types.PendingSendToEthereum{SendToEthereum: *send, ReleaseHeight: releaseHeight})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSendToEthereumPending,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(send.Id)),
+		sdk.NewAttribute(types.AttributeKeyReleaseHeight, fmt.Sprint(releaseHeight)),
+	))
+}
+
+func (k Keeper) setPendingSendToEthereum(ctx sdk.Context, pending types.PendingSendToEthereum) {
+	key := types.MakePendingSendToEthereumKey(pending.ReleaseHeight, pending.SendToEthereum.Id)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&pending))
+}
+
+// IteratePendingSendToEthereums iterates through the held sends to ethereum in release height order
+func (k Keeper) IteratePendingSendToEthereums(ctx sdk.Context, cb func(types.PendingSendToEthereum) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.PendingSendToEthereumKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var pending types.PendingSendToEthereum
+		k.cdc.MustUnmarshal(iter.Value(), &pending)
+		if cb(pending) {
+			break
+		}
+	}
+}
+
+// getPendingSendToEthereum returns the held send to ethereum with the id, or nil if it is not held
+func (k Keeper) getPendingSendToEthereum(ctx sdk.Context, id uint64) *types.PendingSendToEthereum {
+	var pending *types.PendingSendToEthereum
+	k.IteratePendingSendToEthereums(ctx, func(p types.PendingSendToEthereum) bool {
+		if p.SendToEthereum.Id == id {
+			pending = &p
+			return true
+		}
+		return false
+	})
+	return pending
+}
+
+// HandleCancelPendingSendToEthereumProposal cancels the held sends to ethereum of the proposal. Sends that were
+// released or cancelled while the proposal was voted on are skipped
+func (k Keeper) HandleCancelPendingSendToEthereumProposal(ctx sdk.Context, p *types.CancelPendingSendToEthereumProposal) error {
+	for _, id := range p.Ids {
+		if k.getPendingSendToEthereum(ctx, id) == nil {
+			k.Logger(ctx).Info("skipping send to ethereum that is no longer held", "id", id)
+			continue
+		}
+		if err := k.CancelPendingSendToEthereum(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CancelPendingSendToEthereum cancels a held send to ethereum and refunds its sender
+func (k Keeper) CancelPendingSendToEthereum(ctx sdk.Context, id uint64) error {
+	pending := k.getPendingSendToEthereum(ctx, id)
+	if pending == nil {
+		return sdkerrors.Wrapf(types.ErrInvalid, "id %d not found in pending sends to ethereum", id)
+	}
+
+	if err := k.refundSendToEthereum(ctx, &pending.SendToEthereum); err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.MakePendingSendToEthereumKey(pending.ReleaseHeight, id))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBridgeWithdrawCanceled,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+		sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(id)),
+	))
+
+	return nil
+}
+
+// ReleasePendingSendToEthereums moves the held sends to ethereum that reached their release height to the pool
+func (k Keeper) ReleasePendingSendToEthereums(ctx sdk.Context) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.PendingSendToEthereumKey}).
+		Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())+1))
+
+	var released []types.PendingSendToEthereum
+	for ; iter.Valid(); iter.Next() {
+		var pending types.PendingSendToEthereum
+		k.cdc.MustUnmarshal(iter.Value(), &pending)
+		released = append(released, pending)
+	}
+	iter.Close()
+
+	for _, pending := range released {
+		ctx.KVStore(k.storeKey).Delete(types.MakePendingSendToEthereumKey(pending.ReleaseHeight, pending.SendToEthereum.Id))
+		send := pending.SendToEthereum
+		k.setUnbatchedSendToEthereum(ctx, &send)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSendToEthereumReleased,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(send.Id)),
+		))
+	}
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+func TestPendingSendToEthereum(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context.WithBlockHeight(100)
+	k := input.GravityKeeper
+	msgServer := NewMsgServerImpl(k)
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		denom               = types.NewERC20Token(0, myTokenContractAddr.Hex()).GravityCoin().Denom
+		guardian            = AccAddrs[0]
+	)
+
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(denom, 99999)}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	params := k.GetParams(ctx)
+	params.LargeWithdrawalThresholds = []types.LargeWithdrawalThreshold{{Denom: denom, Amount: sdk.NewInt(1000)}}
+	params.LargeWithdrawalDelay = 10
+	params.WithdrawalGuardian = guardian.String()
+	k.SetParams(ctx, params)
+
+	send := func(ctx sdk.Context, amount int64) uint64 {
+		id, err := k.createSendToEthereum(ctx, mySender, myReceiver.Hex(), sdk.NewInt64Coin(denom, amount), sdk.NewInt64Coin(denom, 0), 0)
+		require.NoError(t, err)
+		return id
+	}
+	unbatched := func(ctx sdk.Context) (ids []uint64) {
+		for _, ste := range k.getUnbatchedSendToEthereums(ctx) {
+			ids = append(ids, ste.Id)
+		}
+		return ids
+	}
+
+	// sends up to the threshold go straight to the pool, larger ones are held
+	small := send(ctx, 1000)
+	large := send(ctx, 1001)
+	cancelled := send(ctx, 2000)
+	require.Equal(t, []uint64{small}, unbatched(ctx))
+
+	res, err := k.PendingSendToEthereums(sdk.WrapSDKContext(ctx), &types.PendingSendToEthereumsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.PendingSendToEthereums, 2)
+	require.Equal(t, uint64(110), res.PendingSendToEthereums[0].ReleaseHeight)
+
+	// only the guardian may cancel a held send
+	_, err = msgServer.CancelPendingSendToEthereum(sdk.WrapSDKContext(ctx), types.NewMsgCancelPendingSendToEthereum(cancelled, mySender))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	balance := input.BankKeeper.GetBalance(ctx, mySender, denom).Amount
+	_, err = msgServer.CancelPendingSendToEthereum(sdk.WrapSDKContext(ctx), types.NewMsgCancelPendingSendToEthereum(cancelled, guardian))
+	require.NoError(t, err)
+	require.Equal(t, balance.AddRaw(2000), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+
+	// a cancelled send can't be cancelled twice
+	require.Error(t, k.CancelPendingSendToEthereum(ctx, cancelled))
+
+	// held sends are released once the delay expires
+	k.ReleasePendingSendToEthereums(ctx.WithBlockHeight(109))
+	require.Equal(t, []uint64{small}, unbatched(ctx))
+
+	k.ReleasePendingSendToEthereums(ctx.WithBlockHeight(110))
+	require.ElementsMatch(t, []uint64{small, large}, unbatched(ctx))
+
+	res, err = k.PendingSendToEthereums(sdk.WrapSDKContext(ctx), &types.PendingSendToEthereumsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.PendingSendToEthereums)
+}
+
+func TestPendingSendToEthereumSplitOverRecipients(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context.WithBlockHeight(100)
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		denom               = types.NewERC20Token(0, myTokenContractAddr.Hex()).GravityCoin().Denom
+	)
+
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(denom, 99999)}
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	params := k.GetParams(ctx)
+	params.LargeWithdrawalThresholds = []types.LargeWithdrawalThreshold{{Denom: denom, Amount: sdk.NewInt(1000)}}
+	params.LargeWithdrawalDelay = 10
+	k.SetParams(ctx, params)
+
+	recipient := types.SendToEthereumRecipient{
+		EthereumRecipient: myReceiver.Hex(),
+		Amount:            sdk.NewInt64Coin(denom, 600),
+		BridgeFee:         sdk.NewInt64Coin(denom, 0),
+	}
+
+	// each send is below the threshold, the message isn't
+	ids, err := k.createSendToEthereums(ctx, mySender, []types.SendToEthereumRecipient{recipient, recipient}, 0)
+	require.NoError(t, err)
+	require.Empty(t, k.getUnbatchedSendToEthereums(ctx))
+
+	res, err := k.PendingSendToEthereums(sdk.WrapSDKContext(ctx), &types.PendingSendToEthereumsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.PendingSendToEthereums, 2)
+
+	// they are released together
+	k.ReleasePendingSendToEthereums(ctx.WithBlockHeight(110))
+	var unbatched []uint64
+	for _, ste := range k.getUnbatchedSendToEthereums(ctx) {
+		unbatched = append(unbatched, ste.Id)
+	}
+	require.ElementsMatch(t, ids, unbatched)
+}
@@ -13,13 +13,43 @@ import (
 	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
 )
 
-// createSendToEthereum
-// - checks a counterpart denominator exists for the given voucher type
-// - burns the voucher for transfer amount and fees
-// - persists an OutgoingTx
-// - adds the TX to the `available` TX pool via a second index
-func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, counterpartReceiver string, amount sdk.Coin, fee sdk.Coin) (uint64, error) {
-	totalAmount := amount.Add(fee)
+// createSendToEthereum creates a single send to ethereum, see createSendToEthereums
+func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, counterpartReceiver string, amount sdk.Coin, fee sdk.Coin, expiresAtHeight uint64) (uint64, error) {
+	ids, err := k.createSendToEthereums(ctx, sender, []types.SendToEthereumRecipient{{
+		EthereumRecipient: counterpartReceiver,
+		Amount:            amount,
+		BridgeFee:         fee,
+	}}, expiresAtHeight)
+	if err != nil {
+		return 0, err
+	}
+	return ids[0], nil
+}
+
+// createSendToEthereums
+// - checks a counterpart denominator exists for the voucher type shared by the recipients
+// - checks the total of the transfer amounts and fees fits in the outflow rate limit of the denom
+// - burns the vouchers for the total of the transfer amounts and fees
+// - persists an OutgoingTx per recipient with consecutive ids
+// - adds each TX to the `available` TX pool via a second index, or holds every TX for the
+//   large withdrawal delay if their total is above the threshold of its denom, so a large
+//   withdrawal can't get around the delay by being split over several recipients
+// - indexes the TXs by their expiry height if they expire
+func (k Keeper) createSendToEthereums(ctx sdk.Context, sender sdk.AccAddress, recipients []types.SendToEthereumRecipient, expiresAtHeight uint64) ([]uint64, error) {
+	if len(recipients) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "no recipients")
+	}
+	if expiresAtHeight != 0 && expiresAtHeight <= uint64(ctx.BlockHeight()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "expiry height %d is not after the current height %d", expiresAtHeight, ctx.BlockHeight())
+	}
+
+	totalAmount := sdk.NewCoin(recipients[0].Amount.Denom, sdk.ZeroInt())
+	for _, recipient := range recipients {
+		if recipient.Amount.Denom != totalAmount.Denom || recipient.BridgeFee.Denom != totalAmount.Denom {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amounts and fees must be of the same type %s", totalAmount.Denom)
+		}
+		totalAmount = totalAmount.Add(recipient.Amount).Add(recipient.BridgeFee)
+	}
 	totalInVouchers := sdk.Coins{totalAmount}
 
 	// If the coin is a gravity voucher, burn the coins. If not, check if there is a deployed ERC20 contract representing it.
@@ -27,11 +57,15 @@ func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, cou
 
 	isCosmosOriginated, tokenContract, err := k.DenomToERC20Lookup(ctx, totalAmount.Denom)
 	if err != nil {
-		return 0, err
+		return nil, err
+	}
+
+	if err := k.recordOutflow(ctx, totalAmount); err != nil {
+		return nil, err
 	}
 
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, totalInVouchers); err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	// If it is no a cosmos-originated asset we burn
@@ -41,23 +75,35 @@ func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, cou
 		}
 	}
 
-	// get next tx id from keeper
-	nextID := k.incrementLastSendToEthereumIDKey(ctx)
+	large := k.isLargeWithdrawal(ctx, totalAmount)
+	ids := make([]uint64, len(recipients))
+	for i, recipient := range recipients {
+		// get next tx id from keeper
+		ids[i] = k.incrementLastSendToEthereumIDKey(ctx)
 
-	// construct outgoing tx, as part of this process we represent
-	// the token as an ERC20 token since it is preparing to go to ETH
-	// rather than the denom that is the input to this function.
+		// construct outgoing tx, as part of this process we represent
+		// the token as an ERC20 token since it is preparing to go to ETH
+		// rather than the denom that is the input to this function.
 
-	// set the outgoing tx in the pool index
-	k.setUnbatchedSendToEthereum(ctx, &types.SendToEthereum{
-		Id:                nextID,
-		Sender:            sender.String(),
-		EthereumRecipient: counterpartReceiver,
-		Erc20Token:        types.NewSDKIntERC20Token(amount.Amount, tokenContract),
-		Erc20Fee:          types.NewSDKIntERC20Token(fee.Amount, tokenContract),
-	})
+		send := &types.SendToEthereum{
+			Id:                ids[i],
+			Sender:            sender.String(),
+			EthereumRecipient: recipient.EthereumRecipient,
+			Erc20Token:        types.NewSDKIntERC20Token(recipient.Amount.Amount, tokenContract),
+			Erc20Fee:          types.NewSDKIntERC20Token(recipient.BridgeFee.Amount, tokenContract),
+			ExpiresAtHeight:   expiresAtHeight,
+		}
 
-	return nextID, nil
+		if large {
+			k.holdSendToEthereum(ctx, send, uint64(ctx.BlockHeight())+k.GetParams(ctx).LargeWithdrawalDelay)
+			continue
+		}
+
+		// set the outgoing tx in the pool index
+		k.setUnbatchedSendToEthereum(ctx, send)
+	}
+
+	return ids, nil
 }
 
 // cancelSendToEthereum
@@ -82,10 +128,82 @@ func (k Keeper) cancelSendToEthereum(ctx sdk.Context, id uint64, s string) error
 		return fmt.Errorf("can't cancel a message you didn't send")
 	}
 
-	totalToRefund := send.Erc20Token.GravityCoin()
-	totalToRefund.Amount = totalToRefund.Amount.Add(send.Erc20Fee.Amount)
-	totalToRefundCoins := sdk.NewCoins(totalToRefund)
-	isCosmosOriginated, _ := k.ERC20ToDenomLookup(ctx, send.Erc20Token.Contract)
+	if err := k.refundSendToEthereum(ctx, send); err != nil {
+		return err
+	}
+
+	k.deleteUnbatchedSendToEthereum(ctx, send)
+	return nil
+}
+
+// increaseSendToEthereumFee
+// - checks that the provided tx is unbatched and was sent by the sender
+// - checks the additional fee is of the denom of the tx and fits in the outflow rate limit
+// - burns or locks the additional fee
+// - re-keys the tx in the pool under its new fee
+func (k Keeper) increaseSendToEthereumFee(ctx sdk.Context, id uint64, s string, additionalFee sdk.Coin) error {
+	sender, _ := sdk.AccAddressFromBech32(s)
+
+	isCosmosOriginated, tokenContract, err := k.DenomToERC20Lookup(ctx, additionalFee.Denom)
+	if err != nil {
+		return err
+	}
+
+	var send *types.SendToEthereum
+	k.iterateUnbatchedSendToEthereumsByContract(ctx, tokenContract, func(ste *types.SendToEthereum) bool {
+		if ste.Id == id {
+			send = ste
+			return true
+		}
+		return false
+	})
+	if send == nil {
+		// NOTE: this case will also be hit if the transaction is in a batch or of another denom
+		return sdkerrors.Wrapf(types.ErrInvalid, "id not found in send to ethereum pool of %s", additionalFee.Denom)
+	}
+
+	if sender.String() != send.Sender {
+		return fmt.Errorf("can't increase the fee of a message you didn't send")
+	}
+
+	if err := k.recordOutflow(ctx, additionalFee); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.Coins{additionalFee}); err != nil {
+		return err
+	}
+
+	// If it is no a cosmos-originated asset we burn
+	if !isCosmosOriginated {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.Coins{additionalFee}); err != nil {
+			panic(err)
+		}
+	}
+
+	oldFee := send.Erc20Fee
+	k.deleteUnbatchedSendToEthereum(ctx, send)
+	send.Erc20Fee = types.NewSDKIntERC20Token(oldFee.Amount.Add(additionalFee.Amount), tokenContract)
+	k.setUnbatchedSendToEthereum(ctx, send)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSendToEthereumFeeIncreased,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(send.Id)),
+		sdk.NewAttribute(types.AttributeKeyTokenContract, tokenContract.Hex()),
+		sdk.NewAttribute(types.AttributeKeyOldFee, oldFee.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyNewFee, send.Erc20Fee.Amount.String()),
+	))
+
+	return nil
+}
+
+// refundSendToEthereum issues the tokens and fees of a send to ethereum back to its sender
+func (k Keeper) refundSendToEthereum(ctx sdk.Context, send *types.SendToEthereum) error {
+	sender, _ := sdk.AccAddressFromBech32(send.Sender)
+
+	isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, send.Erc20Token.Contract)
+	totalToRefundCoins := sdk.NewCoins(sdk.NewCoin(denom, send.Erc20Token.Amount.Add(send.Erc20Fee.Amount)))
 
 	// If it is not cosmos-originated the coins are minted
 	if !isCosmosOriginated {
@@ -98,16 +216,67 @@ func (k Keeper) cancelSendToEthereum(ctx sdk.Context, id uint64, s string) error
 		return sdkerrors.Wrap(err, "sending coins from module account")
 	}
 
-	k.deleteUnbatchedSendToEthereum(ctx, send.Id, send.Erc20Fee)
 	return nil
 }
 
+// RefundExpiredSendToEthereums refunds the unbatched sends to ethereum that reached their expiry height
+func (k Keeper) RefundExpiredSendToEthereums(ctx sdk.Context) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.SendToEthereumExpiryKey}).
+		Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())+1))
+
+	var expired [][]byte
+	for ; iter.Valid(); iter.Next() {
+		var fee types.ERC20Token
+		k.cdc.MustUnmarshal(iter.Value(), &fee)
+		expired = append(expired, types.MakeSendToEthereumKey(binary.BigEndian.Uint64(iter.Key()[8:]), fee))
+	}
+	iter.Close()
+
+	for _, key := range expired {
+		var send types.SendToEthereum
+		k.cdc.MustUnmarshal(ctx.KVStore(k.storeKey).Get(key), &send)
+		k.refundExpiredSendToEthereum(ctx, &send)
+	}
+}
+
+// refundExpiredSendToEthereum removes an expired send to ethereum from the pool and refunds its sender.
+// If the refund fails the send is left in the pool and the refund is retried at the next block.
+func (k Keeper) refundExpiredSendToEthereum(ctx sdk.Context, send *types.SendToEthereum) {
+	xCtx, commit := ctx.CacheContext()
+	k.deleteUnbatchedSendToEthereum(xCtx, send)
+	if err := k.refundSendToEthereum(xCtx, send); err != nil {
+		k.Logger(ctx).Error("expired send to ethereum refund failed", "cause", err.Error(), "id", send.Id)
+		return
+	}
+	commit()
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSendToEthereumExpired,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(send.Id)),
+		sdk.NewAttribute(types.AttributeKeyExpiresAtHeight, fmt.Sprint(send.ExpiresAtHeight)),
+	))
+}
+
+// isExpired returns true if the send to ethereum has an expiry height the chain reached
+func isExpired(ctx sdk.Context, send *types.SendToEthereum) bool {
+	return send.ExpiresAtHeight != 0 && send.ExpiresAtHeight <= uint64(ctx.BlockHeight())
+}
+
 func (k Keeper) setUnbatchedSendToEthereum(ctx sdk.Context, ste *types.SendToEthereum) {
-	ctx.KVStore(k.storeKey).Set(types.MakeSendToEthereumKey(ste.Id, ste.Erc20Fee), k.cdc.MustMarshal(ste))
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MakeSendToEthereumKey(ste.Id, ste.Erc20Fee), k.cdc.MustMarshal(ste))
+	if ste.ExpiresAtHeight != 0 {
+		store.Set(types.MakeSendToEthereumExpiryKey(ste.ExpiresAtHeight, ste.Id), k.cdc.MustMarshal(&ste.Erc20Fee))
+	}
 }
 
-func (k Keeper) deleteUnbatchedSendToEthereum(ctx sdk.Context, id uint64, fee types.ERC20Token) {
-	ctx.KVStore(k.storeKey).Delete(types.MakeSendToEthereumKey(id, fee))
+func (k Keeper) deleteUnbatchedSendToEthereum(ctx sdk.Context, ste *types.SendToEthereum) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.MakeSendToEthereumKey(ste.Id, ste.Erc20Fee))
+	if ste.ExpiresAtHeight != 0 {
+		store.Delete(types.MakeSendToEthereumExpiryKey(ste.ExpiresAtHeight, ste.Id))
+	}
 }
 
 func (k Keeper) iterateUnbatchedSendToEthereumsByContract(ctx sdk.Context, contract common.Address, cb func(*types.SendToEthereum) bool) {
@@ -134,6 +303,30 @@ func (k Keeper) IterateUnbatchedSendToEthereums(ctx sdk.Context, cb func(*types.
 	}
 }
 
+// GetUnbatchedTokenContracts returns the token contracts that have unbatched sends to ethereum, in key order
+func (k Keeper) GetUnbatchedTokenContracts(ctx sdk.Context) []common.Address {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.SendToEthereumKey})
+
+	// the keys start with the token contract, so skip to the end of its keys once one is found
+	var contracts []common.Address
+	var start []byte
+	for {
+		iter := store.Iterator(start, nil)
+		if !iter.Valid() {
+			iter.Close()
+			return contracts
+		}
+		contract := common.BytesToAddress(iter.Key()[:common.AddressLength])
+		iter.Close()
+
+		contracts = append(contracts, contract)
+		start = sdk.PrefixEndBytes(contract.Bytes())
+		if start == nil {
+			return contracts
+		}
+	}
+}
+
 func (k Keeper) getUnbatchedSendToEthereums(ctx sdk.Context) []*types.SendToEthereum {
 	var out []*types.SendToEthereum
 	k.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
@@ -144,14 +337,18 @@ func (k Keeper) getUnbatchedSendToEthereums(ctx sdk.Context) []*types.SendToEthe
 }
 
 func (k Keeper) incrementLastSendToEthereumIDKey(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get([]byte{types.LastSendToEthereumIDKey})
-	var id uint64 = 0
-	if bz != nil {
-		id = binary.BigEndian.Uint64(bz)
-	}
-	newId := id + 1
-	bz = sdk.Uint64ToBigEndian(newId)
-	store.Set([]byte{types.LastSendToEthereumIDKey}, bz)
+	newId := k.getLastSendToEthereumID(ctx) + 1
+	k.setLastSendToEthereumID(ctx, newId)
 	return newId
 }
+
+func (k Keeper) getLastSendToEthereumID(ctx sdk.Context) uint64 {
+	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastSendToEthereumIDKey}); bz != nil {
+		return binary.BigEndian.Uint64(bz)
+	}
+	return 0
+}
+
+func (k Keeper) setLastSendToEthereumID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LastSendToEthereumIDKey}, sdk.Uint64ToBigEndian(id))
+}
@@ -51,3 +51,171 @@ func TestAddToOutgoingPool(t *testing.T) {
 	require.EqualValues(t, exp[3], got[3])
 	require.Len(t, got, 4)
 }
+
+func TestGetUnbatchedTokenContracts(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _ = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver  = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		contractA   = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		contractB   = common.HexToAddress("0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e")
+	)
+	require.Empty(t, input.GravityKeeper.GetUnbatchedTokenContracts(ctx))
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, sdk.NewCoins(
+		types.NewERC20Token(99999, contractA.Hex()).GravityCoin(),
+		types.NewERC20Token(99999, contractB.Hex()).GravityCoin(),
+	)))
+	input.AddSendToEthTxsToPool(t, ctx, contractA, mySender, myReceiver, 2, 3)
+	input.AddSendToEthTxsToPool(t, ctx, contractB, mySender, myReceiver, 1)
+
+	require.Equal(t, []common.Address{contractB, contractA}, input.GravityKeeper.GetUnbatchedTokenContracts(ctx))
+}
+
+func TestCancelCosmosOriginatedSendToEthereum(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		denom               = "ugrav"
+	)
+	input.GravityKeeper.setCosmosOriginatedDenomToERC20(ctx, denom, myTokenContractAddr.Hex())
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, sdk.Coins{sdk.NewInt64Coin(denom, 1000)}))
+
+	id, err := input.GravityKeeper.createSendToEthereum(ctx, mySender, myReceiver.Hex(), sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 10), 0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(890), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+
+	// the escrowed coins are refunded rather than vouchers
+	require.NoError(t, input.GravityKeeper.cancelSendToEthereum(ctx, id, mySender.String()))
+	require.Equal(t, sdk.NewInt(1000), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+}
+
+func TestIncreaseSendToEthereumFee(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		otherSender, _      = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		otherContractAddr   = common.HexToAddress("0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e")
+		voucherDenom        = types.NewERC20Token(0, myTokenContractAddr.Hex()).GravityCoin().Denom
+		otherDenom          = types.NewERC20Token(0, otherContractAddr.Hex()).GravityCoin().Denom
+	)
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, sdk.NewCoins(
+		types.NewERC20Token(99999, myTokenContractAddr.Hex()).GravityCoin(),
+		types.NewERC20Token(99999, otherContractAddr.Hex()).GravityCoin(),
+	)))
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3, 2, 1)
+	supply := input.BankKeeper.GetSupply(ctx, voucherDenom).Amount
+
+	// the lowest fee tx moves to the front of the pool and keeps its id
+	require.NoError(t, input.GravityKeeper.increaseSendToEthereumFee(ctx, 4, mySender.String(), sdk.NewInt64Coin(voucherDenom, 3)))
+
+	var got []*types.SendToEthereum
+	input.GravityKeeper.IterateUnbatchedSendToEthereums(ctx, func(tx *types.SendToEthereum) bool {
+		got = append(got, tx)
+		return false
+	})
+	require.Equal(t, []*types.SendToEthereum{
+		types.NewSendToEthereumTx(4, myTokenContractAddr, mySender, myReceiver, 103, 4),
+		types.NewSendToEthereumTx(2, myTokenContractAddr, mySender, myReceiver, 101, 3),
+		types.NewSendToEthereumTx(3, myTokenContractAddr, mySender, myReceiver, 102, 2),
+		types.NewSendToEthereumTx(1, myTokenContractAddr, mySender, myReceiver, 100, 2),
+	}, got)
+
+	// the additional fee vouchers are burned
+	require.Equal(t, supply.SubRaw(3), input.BankKeeper.GetSupply(ctx, voucherDenom).Amount)
+	require.Equal(t, sdk.NewInt(99999-406-8-3), input.BankKeeper.GetBalance(ctx, mySender, voucherDenom).Amount)
+
+	event := ctx.EventManager().Events()[len(ctx.EventManager().Events())-1]
+	require.Equal(t, types.EventTypeSendToEthereumFeeIncreased, event.Type)
+	require.Equal(t, []string{"1", "4"}, []string{string(event.Attributes[3].Value), string(event.Attributes[4].Value)})
+
+	t.Run("not the sender", func(t *testing.T) {
+		require.Error(t, input.GravityKeeper.increaseSendToEthereumFee(ctx, 4, otherSender.String(), sdk.NewInt64Coin(voucherDenom, 1)))
+	})
+	t.Run("fee of another denom", func(t *testing.T) {
+		require.Error(t, input.GravityKeeper.increaseSendToEthereumFee(ctx, 4, mySender.String(), sdk.NewInt64Coin(otherDenom, 1)))
+	})
+	t.Run("batched tx", func(t *testing.T) {
+		batch := input.GravityKeeper.BuildBatchTx(ctx, myTokenContractAddr, 1)
+		require.NotNil(t, batch)
+		require.Equal(t, uint64(4), batch.Transactions[0].Id)
+		require.Error(t, input.GravityKeeper.increaseSendToEthereumFee(ctx, 4, mySender.String(), sdk.NewInt64Coin(voucherDenom, 1)))
+	})
+}
+
+func TestRefundExpiredSendToEthereums(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context.WithBlockHeight(10)
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		denom               = "ugrav"
+	)
+	k.setCosmosOriginatedDenomToERC20(ctx, denom, myTokenContractAddr.Hex())
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, sdk.Coins{sdk.NewInt64Coin(denom, 1000)}))
+	balance := func() int64 { return input.BankKeeper.GetBalance(ctx, mySender, denom).Amount.Int64() }
+	send := func(fee, expiresAtHeight int64) (uint64, error) {
+		return k.createSendToEthereum(ctx, mySender, myReceiver.Hex(), sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, fee), uint64(expiresAtHeight))
+	}
+	poolIDs := func() []uint64 {
+		var ids []uint64
+		k.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+			ids = append(ids, ste.Id)
+			return false
+		})
+		return ids
+	}
+
+	_, err := send(1, 10)
+	require.Error(t, err)
+	expiring, err := send(10, 12)
+	require.NoError(t, err)
+	neverExpiring, err := send(1, 0)
+	require.NoError(t, err)
+	batched, err := send(20, 15)
+	require.NoError(t, err)
+	require.NoError(t, k.increaseSendToEthereumFee(ctx, expiring, mySender.String(), sdk.NewInt64Coin(denom, 5)))
+	require.Equal(t, int64(1000-115-101-120), balance())
+
+	ctx = ctx.WithBlockHeight(11)
+	k.RefundExpiredSendToEthereums(ctx)
+	require.Equal(t, []uint64{batched, expiring, neverExpiring}, poolIDs())
+
+	// the send is refunded with its increased fee at its expiry height
+	ctx = ctx.WithBlockHeight(12)
+	k.RefundExpiredSendToEthereums(ctx)
+	require.Equal(t, []uint64{batched, neverExpiring}, poolIDs())
+	require.Equal(t, int64(1000-101-120), balance())
+
+	// a batched send isn't refunded while its batch may still be executed
+	ctx = ctx.WithBlockHeight(13)
+	batch := k.BuildBatchTx(ctx, myTokenContractAddr, 1)
+	require.NotNil(t, batch)
+	require.Equal(t, batched, batch.Transactions[0].Id)
+	ctx = ctx.WithBlockHeight(15)
+	k.RefundExpiredSendToEthereums(ctx)
+	require.Equal(t, int64(1000-101-120), balance())
+
+	// but it is refunded rather than returned to the pool once its batch is canceled
+	ctx = ctx.WithBlockHeight(16)
+	k.CancelBatchTx(ctx, myTokenContractAddr, batch.BatchNonce)
+	require.Equal(t, []uint64{neverExpiring}, poolIDs())
+	require.Equal(t, int64(1000-101), balance())
+
+	iter := ctx.KVStore(k.storeKey).Iterator([]byte{types.SendToEthereumExpiryKey}, []byte{types.SendToEthereumExpiryKey + 1})
+	defer iter.Close()
+	require.False(t, iter.Valid())
+}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// HandleValidatorSignature records whether the validator signed an outgoing tx or voted on an ethereum
+// event in its sliding window. It returns true if the validator missed more than the window allows, in
+// which case the window is reset and the caller is expected to slash and jail the validator.
+func (k Keeper) HandleValidatorSignature(ctx sdk.Context, val sdk.ValAddress, missed bool) bool {
+	params := k.GetParams(ctx)
+
+	info, found := k.GetGravitySigningInfo(ctx, val)
+	if !found {
+		info = types.GravitySigningInfo{ValidatorAddress: val.String(), Window: params.SigningInfoWindow}
+	}
+
+	// the bit array was recorded over a window of another size, whose entries don't line up with the
+	// current window, so the window starts over
+	if info.Window != params.SigningInfoWindow {
+		info.IndexOffset = 0
+		info.MissedCounter = 0
+		info.Window = params.SigningInfoWindow
+		k.clearMissedSignatureBitArray(ctx, val)
+	}
+
+	// the bit array is a ring buffer, so the entry at index is the one leaving the window
+	index := info.IndexOffset % params.SigningInfoWindow
+	previous := k.getMissedSignatureBitArray(ctx, val, index)
+	switch {
+	case !previous && missed:
+		k.setMissedSignatureBitArray(ctx, val, index, true)
+		info.MissedCounter++
+	case previous && !missed:
+		k.setMissedSignatureBitArray(ctx, val, index, false)
+		info.MissedCounter--
+	}
+	info.IndexOffset++
+
+	minSigned := params.MinSignedPerWindow.MulInt64(int64(params.SigningInfoWindow)).RoundInt64()
+	maxMissed := int64(params.SigningInfoWindow) - minSigned
+	if int64(info.MissedCounter) > maxMissed {
+		k.Logger(ctx).Info(
+			"validator missed too many ethereum signatures",
+			"validator", val.String(),
+			"missed", info.MissedCounter,
+			"threshold", maxMissed,
+		)
+
+		// start over so the validator isn't punished twice for the same window
+		info.IndexOffset = 0
+		info.MissedCounter = 0
+		k.clearMissedSignatureBitArray(ctx, val)
+		k.setGravitySigningInfo(ctx, info)
+		return true
+	}
+
+	k.setGravitySigningInfo(ctx, info)
+	return false
+}
+
+// GetGravitySigningInfo returns the missed signature counters of a validator
+func (k Keeper) GetGravitySigningInfo(ctx sdk.Context, val sdk.ValAddress) (info types.GravitySigningInfo, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeGravitySigningInfoKey(val))
+	if bz == nil {
+		return info, false
+	}
+	k.cdc.MustUnmarshal(bz, &info)
+	return info, true
+}
+
+// setGravitySigningInfo sets the missed signature counters of a validator
+func (k Keeper) setGravitySigningInfo(ctx sdk.Context, info types.GravitySigningInfo) {
+	val, err := sdk.ValAddressFromBech32(info.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.MakeGravitySigningInfoKey(val), k.cdc.MustMarshal(&info))
+}
+
+// IterateGravitySigningInfos iterates through the missed signature counters of all validators
+func (k Keeper) IterateGravitySigningInfos(ctx sdk.Context, cb func(types.GravitySigningInfo) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.GravitySigningInfoKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var info types.GravitySigningInfo
+		k.cdc.MustUnmarshal(iter.Value(), &info)
+		if cb(info) {
+			break
+		}
+	}
+}
+
+// getMissedSignatureBitArray returns true if the validator missed the entry at index of its window
+func (k Keeper) getMissedSignatureBitArray(ctx sdk.Context, val sdk.ValAddress, index uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MakeMissedSignatureBitArrayKey(val, index))
+}
+
+// setMissedSignatureBitArray sets whether the validator missed the entry at index of its window
+func (k Keeper) setMissedSignatureBitArray(ctx sdk.Context, val sdk.ValAddress, index uint64, missed bool) {
+	if missed {
+		ctx.KVStore(k.storeKey).Set(types.MakeMissedSignatureBitArrayKey(val, index), []byte{0x1})
+	} else {
+		ctx.KVStore(k.storeKey).Delete(types.MakeMissedSignatureBitArrayKey(val, index))
+	}
+}
+
+// getMissedSignatureIndexes returns the indexes of the missed entries of the validator's window
+func (k Keeper) getMissedSignatureIndexes(ctx sdk.Context, val sdk.ValAddress) (indexes []uint64) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeMissedSignatureBitArrayPrefix(val)).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		indexes = append(indexes, binary.BigEndian.Uint64(iter.Key()))
+	}
+	return indexes
+}
+
+// clearMissedSignatureBitArray removes every missed entry of the validator's window
+func (k Keeper) clearMissedSignatureBitArray(ctx sdk.Context, val sdk.ValAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeMissedSignatureBitArrayPrefix(val))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
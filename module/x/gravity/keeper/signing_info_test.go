@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleValidatorSignature(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	params := k.GetParams(ctx)
+	params.SigningInfoWindow = 4
+	params.MinSignedPerWindow = sdk.NewDecWithPrec(5, 1)
+	k.SetParams(ctx, params)
+
+	val := ValAddrs[0]
+
+	// two misses in a window of four are tolerated
+	require.False(t, k.HandleValidatorSignature(ctx, val, true))
+	require.False(t, k.HandleValidatorSignature(ctx, val, true))
+	info, found := k.GetGravitySigningInfo(ctx, val)
+	require.True(t, found)
+	require.Equal(t, uint64(2), info.MissedCounter)
+	require.Equal(t, uint64(2), info.IndexOffset)
+
+	// signing again doesn't clear earlier misses that are still in the window
+	require.False(t, k.HandleValidatorSignature(ctx, val, false))
+	require.False(t, k.HandleValidatorSignature(ctx, val, false))
+	info, _ = k.GetGravitySigningInfo(ctx, val)
+	require.Equal(t, uint64(2), info.MissedCounter)
+
+	// the window wraps around and the first miss is overwritten by a signature
+	require.False(t, k.HandleValidatorSignature(ctx, val, false))
+	info, _ = k.GetGravitySigningInfo(ctx, val)
+	require.Equal(t, uint64(1), info.MissedCounter)
+
+	// the second miss is overwritten by a miss, and the window can take one more before crossing the threshold
+	require.False(t, k.HandleValidatorSignature(ctx, val, true))
+	require.False(t, k.HandleValidatorSignature(ctx, val, true))
+	require.True(t, k.HandleValidatorSignature(ctx, val, true))
+
+	// the window starts over after the validator is punished
+	info, _ = k.GetGravitySigningInfo(ctx, val)
+	require.Equal(t, uint64(0), info.MissedCounter)
+	require.Equal(t, uint64(0), info.IndexOffset)
+	for i := uint64(0); i < params.SigningInfoWindow; i++ {
+		require.False(t, k.getMissedSignatureBitArray(ctx, val, i))
+	}
+
+	// the window starts over when its size changes, so misses recorded past the end of a smaller window
+	// don't stay counted
+	require.False(t, k.HandleValidatorSignature(ctx, val, false))
+	require.False(t, k.HandleValidatorSignature(ctx, val, false))
+	require.False(t, k.HandleValidatorSignature(ctx, val, false))
+	require.False(t, k.HandleValidatorSignature(ctx, val, true))
+	require.True(t, k.getMissedSignatureBitArray(ctx, val, 3))
+
+	params.SigningInfoWindow = 2
+	k.SetParams(ctx, params)
+	require.False(t, k.HandleValidatorSignature(ctx, val, false))
+	info, _ = k.GetGravitySigningInfo(ctx, val)
+	require.Equal(t, uint64(0), info.MissedCounter)
+	require.Equal(t, uint64(1), info.IndexOffset)
+	require.Equal(t, uint64(2), info.Window)
+	require.False(t, k.getMissedSignatureBitArray(ctx, val, 3))
+
+	// one miss in a window of two is tolerated
+	require.False(t, k.HandleValidatorSignature(ctx, val, true))
+	require.False(t, k.HandleValidatorSignature(ctx, val, false))
+	info, _ = k.GetGravitySigningInfo(ctx, val)
+	require.Equal(t, uint64(1), info.MissedCounter)
+
+	// other validators are tracked separately
+	_, found = k.GetGravitySigningInfo(ctx, ValAddrs[1])
+	require.False(t, found)
+}
@@ -2,6 +2,9 @@ package keeper
 
 import (
 	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 	"time"
 
@@ -196,6 +199,17 @@ var (
 		SlashFractionBatch:                        sdk.NewDecWithPrec(1, 2),
 		SlashFractionEthereumSignature:            sdk.NewDecWithPrec(1, 2),
 		SlashFractionConflictingEthereumSignature: sdk.NewDecWithPrec(1, 2),
+		SlashFractionBadEthereumSignature:         sdk.NewDecWithPrec(5, 2),
+		SignedContractCallTxsWindow:               20,
+		SlashFractionContractCallTx:               sdk.NewDecWithPrec(1, 2),
+		SigningInfoWindow:                         10,
+		MinSignedPerWindow:                        sdk.OneDec(),
+		EventVoteThreshold:                        sdk.NewDecWithPrec(66, 2),
+		BatchCreationInterval:                     10,
+		MaxBatchSize:                              100,
+		MaxBatchGas:                               5000000,
+		BatchBaseGas:                              500000,
+		BatchTransferGas:                          45000,
 	}
 )
 
@@ -217,7 +231,7 @@ func (input TestInput) AddSendToEthTxsToPool(t *testing.T, ctx sdk.Context, toke
 	for i, id := range ids {
 		amount := types.NewERC20Token(uint64(i+100), tokenContract.Hex()).GravityCoin()
 		fee := types.NewERC20Token(id, tokenContract.Hex()).GravityCoin()
-		_, err := input.GravityKeeper.createSendToEthereum(ctx, sender, receiver.Hex(), amount, fee)
+		_, err := input.GravityKeeper.createSendToEthereum(ctx, sender, receiver.Hex(), amount, fee, 0)
 		require.NoError(t, err)
 	}
 }
@@ -227,7 +241,7 @@ func (input TestInput) AddBalanceToBank(ctx sdk.Context, addr sdk.AccAddress, ba
 }
 
 // SetupFiveValChain does all the initialization for a 5 Validator chain using the keys here
-func SetupFiveValChain(t *testing.T) (TestInput, sdk.Context) {
+func SetupFiveValChain(t testing.TB) (TestInput, sdk.Context) {
 	t.Helper()
 	input := CreateTestEnv(t)
 
@@ -276,7 +290,7 @@ func SetupFiveValChain(t *testing.T) (TestInput, sdk.Context) {
 }
 
 // CreateTestEnv creates the keeper testing environment for gravity
-func CreateTestEnv(t *testing.T) TestInput {
+func CreateTestEnv(t testing.TB) TestInput {
 	t.Helper()
 
 	// Initialize store keys
@@ -431,7 +445,7 @@ func CreateTestEnv(t *testing.T) TestInput {
 		),
 	)
 
-	k.setParams(ctx, TestingGravityParams)
+	k.SetParams(ctx, TestingGravityParams)
 
 	return TestInput{
 		GravityKeeper:  k,
@@ -715,6 +729,37 @@ func fundAccount(ctx sdk.Context, bankKeeper types.BankKeeper, addr sdk.AccAddre
 	return bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, amounts)
 }
 
+// LoadV1StoreFixture writes the gravity store entries of a store fixture written by version 1 of the module,
+// see testdata/v1_store.json, and funds the fixture balances with newly minted coins
+func LoadV1StoreFixture(t testing.TB, ctx sdk.Context, storeKey sdk.StoreKey, bankKeeper types.BankKeeper, path string) {
+	t.Helper()
+
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	var fixture struct {
+		Balances     []banktypes.Balance `json:"balances"`
+		GravityStore []struct {
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		} `json:"gravity_store"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &fixture))
+
+	store := ctx.KVStore(storeKey)
+	for _, entry := range fixture.GravityStore {
+		key, err := hex.DecodeString(entry.Key)
+		require.NoError(t, err)
+		value, err := hex.DecodeString(entry.Value)
+		require.NoError(t, err)
+		store.Set(key, value)
+	}
+
+	for _, balance := range fixture.Balances {
+		require.NoError(t, fundAccount(ctx, bankKeeper, balance.GetAddress(), balance.Coins))
+	}
+}
+
 func fundModAccount(ctx sdk.Context, bankKeeper types.BankKeeper, recipientMod string, amounts sdk.Coins) error {
 	if err := bankKeeper.MintCoins(ctx, types.ModuleName, amounts); err != nil {
 		return err
@@ -0,0 +1,29 @@
+package gravity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/keeper"
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// NewGravityProposalHandler returns a handler for the gravity governance proposals
+func NewGravityProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ResumeBridgeProposal:
+			return k.ResumeBridge(ctx)
+
+		case *types.BridgePauseProposal:
+			return k.HandleBridgePauseProposal(ctx, c)
+
+		case *types.CancelPendingSendToEthereumProposal:
+			return k.HandleCancelPendingSendToEthereumProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gravity proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package gravity_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity"
+	"github.com/cosmos/gravity-bridge/module/x/gravity/keeper"
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+func TestResumeBridgeProposal(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	h := gravity.NewGravityProposalHandler(gravityKeeper)
+
+	// the bridge can't be resumed if it isn't halted
+	require.Error(t, h(ctx, types.NewResumeBridgeProposal("resume", "resume the bridge")))
+
+	// halt the bridge with an executed signer set the chain never produced
+	signerSet := gravityKeeper.CreateSignerSetTx(ctx)
+	require.NoError(t, gravityKeeper.EthereumEventProcessor.Handle(ctx, &types.SignerSetTxExecutedEvent{
+		EventNonce:       1,
+		SignerSetTxNonce: signerSet.Nonce,
+		EthereumHeight:   1,
+		Members:          types.EthereumSigners{{Power: 4294967295, EthereumAddress: keeper.EthAddrs[0].String()}},
+	}))
+	require.True(t, gravityKeeper.IsBridgeHalted(ctx))
+
+	require.NoError(t, h(ctx, types.NewResumeBridgeProposal("resume", "resume the bridge")))
+	require.False(t, gravityKeeper.IsBridgeHalted(ctx))
+
+	// other proposals are refused
+	require.Error(t, h(ctx, govtypes.NewTextProposal("text", "not a gravity proposal")))
+}
+
+func TestBridgePauseProposal(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	h := gravity.NewGravityProposalHandler(gravityKeeper)
+	msgServer := keeper.NewMsgServerImpl(gravityKeeper)
+
+	pausedToken := types.NewERC20Token(100, keeper.TokenContractAddrs[0])
+	otherToken := types.NewERC20Token(100, keeper.TokenContractAddrs[1])
+	sender := keeper.AccAddrs[0]
+	coins := sdk.NewCoins(pausedToken.GravityCoin(), otherToken.GravityCoin())
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coins))
+
+	sendToEthereum := func(token types.ERC20Token) error {
+		_, err := msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), &types.MsgSendToEthereum{
+			Sender:            sender.String(),
+			EthereumRecipient: keeper.EthAddrs[0].String(),
+			Amount:            types.NewERC20Token(10, token.Contract).GravityCoin(),
+			BridgeFee:         types.NewERC20Token(1, token.Contract).GravityCoin(),
+		})
+		return err
+	}
+
+	// pause the first token by its denom
+	require.NoError(t, h(ctx, types.NewBridgePauseProposal("pause", "pause a token", true, false, []string{pausedToken.GravityCoin().Denom})))
+	require.True(t, gravityKeeper.IsTokenPaused(ctx, common.HexToAddress(pausedToken.Contract)))
+	require.False(t, gravityKeeper.IsTokenPaused(ctx, common.HexToAddress(otherToken.Contract)))
+
+	require.ErrorIs(t, sendToEthereum(pausedToken), types.ErrBridgePaused)
+	require.NoError(t, sendToEthereum(otherToken))
+
+	// deposits of the paused token are held instead of minted
+	receiver := keeper.AccAddrs[1]
+	require.NoError(t, gravityKeeper.EthereumEventProcessor.Handle(ctx, &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  pausedToken.Contract,
+		Amount:         sdk.NewInt(50),
+		EthereumSender: keeper.EthAddrs[0].String(),
+		CosmosReceiver: receiver.String(),
+		EthereumHeight: 1,
+	}))
+	require.True(t, input.BankKeeper.GetBalance(ctx, receiver, pausedToken.GravityCoin().Denom).IsZero())
+
+	res, err := gravityKeeper.BridgePauseState(sdk.WrapSDKContext(ctx), &types.BridgePauseStateRequest{})
+	require.NoError(t, err)
+	require.False(t, res.GlobalPaused)
+	require.Equal(t, []string{common.HexToAddress(pausedToken.Contract).Hex()}, res.PausedTokenContracts)
+
+	// held deposits stay held until the token is resumed
+	gravity.EndBlocker(ctx, gravityKeeper)
+	require.True(t, input.BankKeeper.GetBalance(ctx, receiver, pausedToken.GravityCoin().Denom).IsZero())
+
+	// resuming the token while the bridge is halted keeps its deposits held until the halt is lifted
+	signerSet := gravityKeeper.CreateSignerSetTx(ctx)
+	require.NoError(t, gravityKeeper.EthereumEventProcessor.Handle(ctx, &types.SignerSetTxExecutedEvent{
+		EventNonce:       2,
+		SignerSetTxNonce: signerSet.Nonce,
+		EthereumHeight:   2,
+		Members:          types.EthereumSigners{{Power: 4294967295, EthereumAddress: keeper.EthAddrs[0].String()}},
+	}))
+	require.True(t, gravityKeeper.IsBridgeHalted(ctx))
+
+	require.NoError(t, h(ctx, types.NewBridgePauseProposal("resume", "resume a token", false, false, []string{pausedToken.Contract})))
+	require.True(t, input.BankKeeper.GetBalance(ctx, receiver, pausedToken.GravityCoin().Denom).IsZero())
+
+	require.NoError(t, h(ctx, types.NewResumeBridgeProposal("resume", "resume the bridge")))
+	require.Equal(t, sdk.NewInt(50), input.BankKeeper.GetBalance(ctx, receiver, pausedToken.GravityCoin().Denom).Amount)
+	require.NoError(t, sendToEthereum(pausedToken))
+
+	// deposits held while the bridge is running are processed by the proposal resuming their token
+	require.NoError(t, h(ctx, types.NewBridgePauseProposal("pause", "pause a token", true, false, []string{pausedToken.Contract})))
+	require.NoError(t, gravityKeeper.EthereumEventProcessor.Handle(ctx, &types.SendToCosmosEvent{
+		EventNonce:     3,
+		TokenContract:  pausedToken.Contract,
+		Amount:         sdk.NewInt(25),
+		EthereumSender: keeper.EthAddrs[0].String(),
+		CosmosReceiver: receiver.String(),
+		EthereumHeight: 3,
+	}))
+	require.Equal(t, sdk.NewInt(50), input.BankKeeper.GetBalance(ctx, receiver, pausedToken.GravityCoin().Denom).Amount)
+
+	require.NoError(t, h(ctx, types.NewBridgePauseProposal("resume", "resume a token", false, false, []string{pausedToken.Contract})))
+	require.Equal(t, sdk.NewInt(75), input.BankKeeper.GetBalance(ctx, receiver, pausedToken.GravityCoin().Denom).Amount)
+
+	// a global pause covers every token
+	require.NoError(t, h(ctx, types.NewBridgePauseProposal("pause", "pause the bridge", true, true, nil)))
+	require.ErrorIs(t, sendToEthereum(otherToken), types.ErrBridgePaused)
+
+	require.NoError(t, h(ctx, types.NewBridgePauseProposal("resume", "resume the bridge", false, true, nil)))
+	require.NoError(t, sendToEthereum(otherToken))
+}
+
+func TestCancelPendingSendToEthereumProposal(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	h := gravity.NewGravityProposalHandler(gravityKeeper)
+	msgServer := keeper.NewMsgServerImpl(gravityKeeper)
+
+	token := types.NewERC20Token(5000, common.HexToAddress(keeper.TokenContractAddrs[0]).Hex())
+	sender := keeper.AccAddrs[0]
+	coins := sdk.NewCoins(token.GravityCoin())
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coins))
+
+	params := gravityKeeper.GetParams(ctx)
+	params.LargeWithdrawalThresholds = []types.LargeWithdrawalThreshold{{Denom: token.GravityCoin().Denom, Amount: sdk.NewInt(100)}}
+	gravityKeeper.SetParams(ctx, params)
+
+	res, err := msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), &types.MsgSendToEthereum{
+		Sender:            sender.String(),
+		EthereumRecipient: keeper.EthAddrs[0].String(),
+		Amount:            types.NewERC20Token(1000, token.Contract).GravityCoin(),
+		BridgeFee:         types.NewERC20Token(1, token.Contract).GravityCoin(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(3999), input.BankKeeper.GetBalance(ctx, sender, token.GravityCoin().Denom).Amount)
+
+	// ids that are no longer held are skipped and the rest are still cancelled
+	require.NoError(t, h(ctx, types.NewCancelPendingSendToEthereumProposal("cancel", "cancel a send", []uint64{res.Id + 1, res.Id})))
+	require.Equal(t, sdk.NewInt(5000), input.BankKeeper.GetBalance(ctx, sender, token.GravityCoin().Denom).Amount)
+
+	// cancelling the same send again doesn't refund it twice
+	require.NoError(t, h(ctx, types.NewCancelPendingSendToEthereumProposal("cancel", "cancel a send", []uint64{res.Id})))
+	require.Equal(t, sdk.NewInt(5000), input.BankKeeper.GetBalance(ctx, sender, token.GravityCoin().Denom).Amount)
+
+	// the cancelled send is never released
+	gravity.BeginBlocker(ctx.WithBlockHeight(ctx.BlockHeight()+int64(params.LargeWithdrawalDelay)), gravityKeeper)
+	unbatched, err := gravityKeeper.UnbatchedSendToEthereums(sdk.WrapSDKContext(ctx), &types.UnbatchedSendToEthereumsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, unbatched.SendToEthereums)
+}
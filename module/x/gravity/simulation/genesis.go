@@ -0,0 +1,203 @@
+package simulation
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// Simulation parameter constants
+const (
+	SignedSignerSetTxsWindow    = "signed_signer_set_txs_window"
+	SignedBatchesWindow         = "signed_batches_window"
+	SignedContractCallTxsWindow = "signed_contract_call_txs_window"
+	EthereumSignaturesWindow    = "ethereum_signatures_window"
+	TargetEthTxTimeout          = "target_eth_tx_timeout"
+	SigningInfoWindow           = "signing_info_window"
+	MinSignedPerWindow          = "min_signed_per_window"
+	EventVoteThreshold          = "event_vote_threshold"
+	BatchCreationInterval       = "batch_creation_interval"
+	MaxBatchSize                = "max_batch_size"
+	MaxOutstandingBatches       = "max_outstanding_batches"
+	MaxBatchGas                 = "max_batch_gas"
+	CosmosOriginatedBondDenom   = "cosmos_originated_bond_denom"
+)
+
+// TokenContracts are the ethereum originated ERC20s deposited by the simulated orchestrators
+var TokenContracts = []common.Address{
+	common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"),
+	common.HexToAddress("0x7c2C195CD6D34B8F845992d380aADB2730bB9C6F"),
+	common.HexToAddress("0x8858eeB3DfffA017D4BCE9801D340D36Cf895CCf"),
+}
+
+// BondDenomContract is the ERC20 of the bond denom when it is bridged as a cosmos originated asset
+var BondDenomContract = common.HexToAddress("0x0b306bf915c4d645ff596e518faf3f9669b97016")
+
+// EthereumKey returns the ethereum key the simulated account signs with as an orchestrator
+func EthereumKey(acc simtypes.Account) *ecdsa.PrivateKey {
+	key, err := ethcrypto.ToECDSA(acc.PrivKey.Bytes())
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// EthereumAddress returns the ethereum address of the simulated account
+func EthereumAddress(acc simtypes.Account) common.Address {
+	return ethcrypto.PubkeyToAddress(EthereumKey(acc).PublicKey)
+}
+
+// GenSignedTxsWindow randomized window of blocks validators have to sign an outgoing tx
+func GenSignedTxsWindow(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1000, 10000))
+}
+
+// GenEthereumSignaturesWindow randomized EthereumSignaturesWindow
+func GenEthereumSignaturesWindow(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 100, 10000))
+}
+
+// GenTargetEthTxTimeout randomized TargetEthTxTimeout
+func GenTargetEthTxTimeout(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 60000, 43200000))
+}
+
+// GenSigningInfoWindow randomized SigningInfoWindow
+func GenSigningInfoWindow(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1000, 10000))
+}
+
+// GenMinSignedPerWindow randomized MinSignedPerWindow
+func GenMinSignedPerWindow(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(6)), 1)
+}
+
+// GenEventVoteThreshold randomized EventVoteThreshold
+func GenEventVoteThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 51, 100)), 2)
+}
+
+// GenBatchCreationInterval randomized BatchCreationInterval
+func GenBatchCreationInterval(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 20))
+}
+
+// GenMaxBatchSize randomized MaxBatchSize
+func GenMaxBatchSize(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 100))
+}
+
+// GenMaxOutstandingBatches randomized MaxOutstandingBatches
+func GenMaxOutstandingBatches(r *rand.Rand) uint64 {
+	return uint64(r.Intn(5))
+}
+
+// GenMaxBatchGas randomized MaxBatchGas, with room for at least one transfer with the default gas params
+func GenMaxBatchGas(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1000000, 10000000))
+}
+
+// GenCosmosOriginatedBondDenom returns whether the bond denom is bridged as a cosmos originated asset
+func GenCosmosOriginatedBondDenom(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// RandomizedGenState generates a random GenesisState for gravity
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SignedSignerSetTxsWindow, &params.SignedSignerSetTxsWindow, simState.Rand,
+		func(r *rand.Rand) { params.SignedSignerSetTxsWindow = GenSignedTxsWindow(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SignedBatchesWindow, &params.SignedBatchesWindow, simState.Rand,
+		func(r *rand.Rand) { params.SignedBatchesWindow = GenSignedTxsWindow(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SignedContractCallTxsWindow, &params.SignedContractCallTxsWindow, simState.Rand,
+		func(r *rand.Rand) { params.SignedContractCallTxsWindow = GenSignedTxsWindow(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EthereumSignaturesWindow, &params.EthereumSignaturesWindow, simState.Rand,
+		func(r *rand.Rand) { params.EthereumSignaturesWindow = GenEthereumSignaturesWindow(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TargetEthTxTimeout, &params.TargetEthTxTimeout, simState.Rand,
+		func(r *rand.Rand) { params.TargetEthTxTimeout = GenTargetEthTxTimeout(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SigningInfoWindow, &params.SigningInfoWindow, simState.Rand,
+		func(r *rand.Rand) { params.SigningInfoWindow = GenSigningInfoWindow(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinSignedPerWindow, &params.MinSignedPerWindow, simState.Rand,
+		func(r *rand.Rand) { params.MinSignedPerWindow = GenMinSignedPerWindow(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EventVoteThreshold, &params.EventVoteThreshold, simState.Rand,
+		func(r *rand.Rand) { params.EventVoteThreshold = GenEventVoteThreshold(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BatchCreationInterval, &params.BatchCreationInterval, simState.Rand,
+		func(r *rand.Rand) { params.BatchCreationInterval = GenBatchCreationInterval(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxBatchSize, &params.MaxBatchSize, simState.Rand,
+		func(r *rand.Rand) { params.MaxBatchSize = GenMaxBatchSize(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxOutstandingBatches, &params.MaxOutstandingBatches, simState.Rand,
+		func(r *rand.Rand) { params.MaxOutstandingBatches = GenMaxOutstandingBatches(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxBatchGas, &params.MaxBatchGas, simState.Rand,
+		func(r *rand.Rand) { params.MaxBatchGas = GenMaxBatchGas(r) },
+	)
+
+	var cosmosOriginatedBondDenom bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CosmosOriginatedBondDenom, &cosmosOriginatedBondDenom, simState.Rand,
+		func(r *rand.Rand) { cosmosOriginatedBondDenom = GenCosmosOriginatedBondDenom(r) },
+	)
+
+	// the initially bonded validators are the first accounts, they orchestrate for themselves
+	var delegateKeys []*types.MsgDelegateKeys
+	for _, acc := range simState.Accounts[:simState.NumBonded] {
+		delegateKeys = append(delegateKeys, &types.MsgDelegateKeys{
+			ValidatorAddress:    sdk.ValAddress(acc.Address).String(),
+			OrchestratorAddress: acc.Address.String(),
+			EthereumAddress:     EthereumAddress(acc).Hex(),
+		})
+	}
+
+	var erc20ToDenoms []*types.ERC20ToDenom
+	if cosmosOriginatedBondDenom {
+		erc20ToDenoms = append(erc20ToDenoms, &types.ERC20ToDenom{
+			Erc20: BondDenomContract.Hex(),
+			Denom: sdk.DefaultBondDenom,
+		})
+	}
+
+	gravityGenesis := types.GenesisState{
+		Params:        params,
+		DelegateKeys:  delegateKeys,
+		Erc20ToDenoms: erc20ToDenoms,
+	}
+
+	bz, err := json.MarshalIndent(&gravityGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated gravity parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&gravityGenesis)
+}
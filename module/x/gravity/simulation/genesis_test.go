@@ -0,0 +1,50 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/keeper"
+	"github.com/cosmos/gravity-bridge/module/x/gravity/simulation"
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// TestRandomizedGenState tests the normal scenario of applying RandomizedGenState.
+// Abonormal scenarios are not tested here.
+func TestRandomizedGenState(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          keeper.MakeTestMarshaler(),
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 5),
+		InitialStake: 1000,
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+
+	var gravityGenesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &gravityGenesis)
+
+	require.NoError(t, gravityGenesis.ValidateBasic())
+	require.GreaterOrEqual(t, gravityGenesis.Params.SignedBatchesWindow, uint64(1000))
+	require.True(t, gravityGenesis.Params.EventVoteThreshold.GT(sdk.NewDecWithPrec(50, 2)))
+
+	// the bonded accounts orchestrate for themselves with their own ethereum keys
+	require.Len(t, gravityGenesis.DelegateKeys, 3)
+	for i, keys := range gravityGenesis.DelegateKeys {
+		acc := simState.Accounts[i]
+		require.Equal(t, sdk.ValAddress(acc.Address).String(), keys.ValidatorAddress)
+		require.Equal(t, acc.Address.String(), keys.OrchestratorAddress)
+		require.Equal(t, simulation.EthereumAddress(acc).Hex(), keys.EthereumAddress)
+	}
+}
@@ -0,0 +1,602 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/keeper"
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSendToEthereum               = "op_weight_msg_send_to_ethereum"
+	OpWeightMsgSendToEthereumMulti          = "op_weight_msg_send_to_ethereum_multi"
+	OpWeightMsgCancelSendToEthereum         = "op_weight_msg_cancel_send_to_ethereum"
+	OpWeightMsgIncreaseSendToEthereumFee    = "op_weight_msg_increase_send_to_ethereum_fee"
+	OpWeightMsgRequestBatchTx               = "op_weight_msg_request_batch_tx"
+	OpWeightMsgDelegateKeys                 = "op_weight_msg_delegate_keys"
+	OpWeightMsgSubmitEthereumTxConfirmation = "op_weight_msg_submit_ethereum_tx_confirmation"
+	OpWeightMsgSubmitEthereumEvent          = "op_weight_msg_submit_ethereum_event"
+
+	DefaultWeightMsgSendToEthereum               = 100
+	DefaultWeightMsgSendToEthereumMulti          = 20
+	DefaultWeightMsgCancelSendToEthereum         = 20
+	DefaultWeightMsgIncreaseSendToEthereumFee    = 20
+	DefaultWeightMsgRequestBatchTx               = 20
+	DefaultWeightMsgDelegateKeys                 = 20
+	DefaultWeightMsgSubmitEthereumTxConfirmation = 100
+	DefaultWeightMsgSubmitEthereumEvent          = 100
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgSendToEthereum int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSendToEthereum, &weightMsgSendToEthereum, nil,
+		func(_ *rand.Rand) { weightMsgSendToEthereum = DefaultWeightMsgSendToEthereum },
+	)
+
+	var weightMsgSendToEthereumMulti int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSendToEthereumMulti, &weightMsgSendToEthereumMulti, nil,
+		func(_ *rand.Rand) { weightMsgSendToEthereumMulti = DefaultWeightMsgSendToEthereumMulti },
+	)
+
+	var weightMsgCancelSendToEthereum int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelSendToEthereum, &weightMsgCancelSendToEthereum, nil,
+		func(_ *rand.Rand) { weightMsgCancelSendToEthereum = DefaultWeightMsgCancelSendToEthereum },
+	)
+
+	var weightMsgIncreaseSendToEthereumFee int
+	appParams.GetOrGenerate(cdc, OpWeightMsgIncreaseSendToEthereumFee, &weightMsgIncreaseSendToEthereumFee, nil,
+		func(_ *rand.Rand) { weightMsgIncreaseSendToEthereumFee = DefaultWeightMsgIncreaseSendToEthereumFee },
+	)
+
+	var weightMsgRequestBatchTx int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRequestBatchTx, &weightMsgRequestBatchTx, nil,
+		func(_ *rand.Rand) { weightMsgRequestBatchTx = DefaultWeightMsgRequestBatchTx },
+	)
+
+	var weightMsgDelegateKeys int
+	appParams.GetOrGenerate(cdc, OpWeightMsgDelegateKeys, &weightMsgDelegateKeys, nil,
+		func(_ *rand.Rand) { weightMsgDelegateKeys = DefaultWeightMsgDelegateKeys },
+	)
+
+	var weightMsgSubmitEthereumTxConfirmation int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSubmitEthereumTxConfirmation, &weightMsgSubmitEthereumTxConfirmation, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitEthereumTxConfirmation = DefaultWeightMsgSubmitEthereumTxConfirmation
+		},
+	)
+
+	var weightMsgSubmitEthereumEvent int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSubmitEthereumEvent, &weightMsgSubmitEthereumEvent, nil,
+		func(_ *rand.Rand) { weightMsgSubmitEthereumEvent = DefaultWeightMsgSubmitEthereumEvent },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgSendToEthereum, SimulateMsgSendToEthereum(cdc, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgSendToEthereumMulti, SimulateMsgSendToEthereumMulti(cdc, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgCancelSendToEthereum, SimulateMsgCancelSendToEthereum(cdc, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgIncreaseSendToEthereumFee, SimulateMsgIncreaseSendToEthereumFee(cdc, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgRequestBatchTx, SimulateMsgRequestBatchTx(cdc, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgDelegateKeys, SimulateMsgDelegateKeys(cdc, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgSubmitEthereumTxConfirmation, SimulateMsgSubmitEthereumTxConfirmation(cdc, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgSubmitEthereumEvent, SimulateMsgSubmitEthereumEvent(cdc, ak, bk, k)),
+	}
+}
+
+// SimulateMsgSendToEthereum generates a MsgSendToEthereum of a random bridged coin of a random account
+func SimulateMsgSendToEthereum(cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgSendToEthereum{}.Type()
+		if k.IsBridgeHalted(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "bridge is halted"), nil, nil
+		}
+
+		simAccount, coin, found := randomBridgeableCoin(r, ctx, accs, bk, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bridgeable coins"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, coin.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate positive amount"), nil, err
+		}
+		fee := simtypes.RandomAmount(r, coin.Amount.Sub(amount))
+
+		recipient, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgSendToEthereum(
+			simAccount.Address,
+			EthereumAddress(recipient).Hex(),
+			sdk.NewCoin(coin.Denom, amount),
+			sdk.NewCoin(coin.Denom, fee),
+		)
+		// some sends expire, possibly before they are batched
+		if r.Intn(2) == 0 {
+			msg.ExpiresAtHeight = uint64(ctx.BlockHeight()) + uint64(simtypes.RandIntBetween(r, 1, 50))
+		}
+
+		return deliver(r, app, ctx, cdc, ak, bk, simAccount, msg, msgType, sdk.NewCoins(sdk.NewCoin(coin.Denom, amount.Add(fee))))
+	}
+}
+
+// SimulateMsgSendToEthereumMulti generates a MsgSendToEthereumMulti of a random bridged coin of a random account
+// to a few random recipients
+func SimulateMsgSendToEthereumMulti(cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgSendToEthereumMulti{}.Type()
+		if k.IsBridgeHalted(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "bridge is halted"), nil, nil
+		}
+
+		simAccount, coin, found := randomBridgeableCoin(r, ctx, accs, bk, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bridgeable coins"), nil, nil
+		}
+
+		// every recipient gets a positive amount of an equal share of the coin
+		count := simtypes.RandIntBetween(r, 1, 6)
+		share := coin.Amount.QuoRaw(int64(count))
+		if !share.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "not enough coins for the recipients"), nil, nil
+		}
+
+		spent := sdk.NewCoin(coin.Denom, sdk.ZeroInt())
+		recipients := make([]types.SendToEthereumRecipient, count)
+		for i := range recipients {
+			amount, err := simtypes.RandPositiveInt(r, share)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate positive amount"), nil, err
+			}
+			fee := simtypes.RandomAmount(r, share.Sub(amount))
+
+			recipient, _ := simtypes.RandomAcc(r, accs)
+			recipients[i] = types.SendToEthereumRecipient{
+				EthereumRecipient: EthereumAddress(recipient).Hex(),
+				Amount:            sdk.NewCoin(coin.Denom, amount),
+				BridgeFee:         sdk.NewCoin(coin.Denom, fee),
+			}
+			spent = spent.Add(recipients[i].Amount).Add(recipients[i].BridgeFee)
+		}
+
+		msg := types.NewMsgSendToEthereumMulti(simAccount.Address, recipients)
+		return deliver(r, app, ctx, cdc, ak, bk, simAccount, msg, msgType, sdk.NewCoins(spent))
+	}
+}
+
+// randomBridgeableCoin returns a random bridgeable coin and its holder. Few accounts hold vouchers,
+// the first account with bridgeable coins from a random offset is returned.
+func randomBridgeableCoin(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, bk types.BankKeeper, k keeper.Keeper,
+) (simtypes.Account, sdk.Coin, bool) {
+	var (
+		simAccount simtypes.Account
+		bridgeable sdk.Coins
+	)
+	offset := r.Intn(len(accs))
+	for i := 0; i < len(accs) && len(bridgeable) == 0; i++ {
+		simAccount = accs[(offset+i)%len(accs)]
+		for _, coin := range bk.SpendableCoins(ctx, simAccount.Address) {
+			_, contract, err := k.DenomToERC20Lookup(ctx, coin.Denom)
+			if err != nil || k.IsTokenPaused(ctx, contract) {
+				continue
+			}
+			bridgeable = append(bridgeable, coin)
+		}
+	}
+	if len(bridgeable) == 0 {
+		return simAccount, sdk.Coin{}, false
+	}
+
+	return simAccount, bridgeable[r.Intn(len(bridgeable))], true
+}
+
+// SimulateMsgCancelSendToEthereum generates a MsgCancelSendToEthereum of a random unbatched send
+func SimulateMsgCancelSendToEthereum(cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgCancelSendToEthereum{}.Type()
+
+		var sends []*types.SendToEthereum
+		k.IterateUnbatchedSendToEthereums(ctx, func(send *types.SendToEthereum) bool {
+			sends = append(sends, send)
+			return false
+		})
+		if len(sends) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unbatched sends"), nil, nil
+		}
+
+		send := sends[r.Intn(len(sends))]
+		sender, err := sdk.AccAddressFromBech32(send.Sender)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid sender"), nil, err
+		}
+		simAccount, found := simtypes.FindAccount(accs, sender)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sender is not a simulated account"), nil, nil
+		}
+
+		msg := types.NewMsgCancelSendToEthereum(send.Id, simAccount.Address)
+		return deliver(r, app, ctx, cdc, ak, bk, simAccount, msg, msgType, nil)
+	}
+}
+
+// SimulateMsgIncreaseSendToEthereumFee generates a MsgIncreaseSendToEthereumFee of a random unbatched send
+func SimulateMsgIncreaseSendToEthereumFee(cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgIncreaseSendToEthereumFee{}.Type()
+		if k.IsBridgeHalted(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "bridge is halted"), nil, nil
+		}
+
+		var sends []*types.SendToEthereum
+		k.IterateUnbatchedSendToEthereums(ctx, func(send *types.SendToEthereum) bool {
+			sends = append(sends, send)
+			return false
+		})
+		if len(sends) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unbatched sends"), nil, nil
+		}
+
+		send := sends[r.Intn(len(sends))]
+		sender, err := sdk.AccAddressFromBech32(send.Sender)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid sender"), nil, err
+		}
+		simAccount, found := simtypes.FindAccount(accs, sender)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sender is not a simulated account"), nil, nil
+		}
+
+		_, denom := k.ERC20ToDenomLookup(ctx, send.Erc20Fee.Contract)
+		balance := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(denom)
+		if !balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no balance for the additional fee"), nil, nil
+		}
+		fee, err := simtypes.RandPositiveInt(r, balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate positive amount"), nil, err
+		}
+
+		msg := types.NewMsgIncreaseSendToEthereumFee(send.Id, simAccount.Address, sdk.NewCoin(denom, fee))
+		return deliver(r, app, ctx, cdc, ak, bk, simAccount, msg, msgType, sdk.NewCoins(msg.AdditionalFee))
+	}
+}
+
+// SimulateMsgRequestBatchTx generates a MsgRequestBatchTx for a random ERC20 with unbatched sends
+func SimulateMsgRequestBatchTx(cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgRequestBatchTx{}.Type()
+		if k.IsBridgeHalted(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "bridge is halted"), nil, nil
+		}
+
+		var contracts []common.Address
+		seen := make(map[common.Address]bool)
+		k.IterateUnbatchedSendToEthereums(ctx, func(send *types.SendToEthereum) bool {
+			contract := common.HexToAddress(send.Erc20Token.Contract)
+			if !seen[contract] {
+				contracts = append(contracts, contract)
+				seen[contract] = true
+			}
+			return false
+		})
+		if len(contracts) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unbatched sends"), nil, nil
+		}
+
+		contract := contracts[r.Intn(len(contracts))]
+		if k.IsTokenPaused(ctx, contract) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "token is paused"), nil, nil
+		}
+
+		// no batch is built while a more profitable one is waiting to be relayed
+		cacheCtx, _ := ctx.CacheContext()
+		if k.BuildBatchTx(cacheCtx, contract, k.BatchCreationPolicy.MaxBatchSize(ctx, contract)) == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "a more profitable batch exists"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		_, denom := k.ERC20ToDenomLookup(ctx, contract.Hex())
+		msg := types.NewMsgRequestBatchTx(denom, simAccount.Address)
+		return deliver(r, app, ctx, cdc, ak, bk, simAccount, msg, msgType, nil)
+	}
+}
+
+// SimulateMsgDelegateKeys generates a MsgDelegateKeys for a random bonded validator without delegate keys,
+// delegating to a random account and the ethereum key of that account
+func SimulateMsgDelegateKeys(cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgDelegateKeys{}).Type()
+
+		var candidates []simtypes.Account
+		for _, validator := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+			if k.GetValidatorEthereumAddress(ctx, validator.GetOperator()) != (common.Address{}) {
+				continue
+			}
+			if acc, found := simtypes.FindAccount(accs, sdk.AccAddress(validator.GetOperator())); found {
+				candidates = append(candidates, acc)
+			}
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bonded validator without delegate keys"), nil, nil
+		}
+
+		valAccount := candidates[r.Intn(len(candidates))]
+		orchestrator, _ := simtypes.RandomAcc(r, accs)
+		ethAddress := EthereumAddress(orchestrator)
+		if k.GetOrchestratorValidatorAddress(ctx, orchestrator.Address) != nil ||
+			k.GetEthereumOrchestratorAddress(ctx, ethAddress) != nil ||
+			k.GetValidatorEthereumAddress(ctx, sdk.ValAddress(orchestrator.Address)) != (common.Address{}) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "orchestrator in use"), nil, nil
+		}
+
+		// the keys are signed over the sequence of the validator account before the tx is delivered
+		account := ak.GetAccount(ctx, valAccount.Address)
+		signMsg := types.DelegateKeysSignMsg{
+			ValidatorAddress: sdk.ValAddress(valAccount.Address).String(),
+			Nonce:            account.GetSequence(),
+		}
+		bz, err := signMsg.Marshal()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to marshal sign msg"), nil, err
+		}
+		signature, err := types.NewEthereumSignature(ethcrypto.Keccak256Hash(bz).Bytes(), EthereumKey(orchestrator))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign delegate keys"), nil, err
+		}
+
+		msg := types.NewMsgDelegateKeys(sdk.ValAddress(valAccount.Address), orchestrator.Address, ethAddress.Hex(), signature)
+		return deliver(r, app, ctx, cdc, ak, bk, valAccount, msg, msgType, nil)
+	}
+}
+
+// SimulateMsgSubmitEthereumTxConfirmation generates a MsgSubmitEthereumTxConfirmation with a valid signature of
+// a random orchestrator for an outgoing tx its validator didn't sign yet
+func SimulateMsgSubmitEthereumTxConfirmation(cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgSubmitEthereumTxConfirmation{}).Type()
+
+		orchestrator, val, found := randomOrchestrator(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bonded orchestrator"), nil, nil
+		}
+
+		var unsigned []types.OutgoingTx
+		for _, prefixByte := range []byte{types.SignerSetTxPrefixByte, types.BatchTxPrefixByte, types.ContractCallTxPrefixByte} {
+			k.IterateOutgoingTxsByType(ctx, prefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+				if _, signed := k.GetEthereumSignatures(ctx, otx.GetStoreIndex())[val.String()]; !signed {
+					unsigned = append(unsigned, otx)
+				}
+				return false
+			})
+		}
+		if len(unsigned) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unsigned outgoing txs"), nil, nil
+		}
+
+		otx := unsigned[r.Intn(len(unsigned))]
+		checkpoint := otx.GetCheckpoint([]byte(k.GetParams(ctx).GravityId))
+		signature, err := types.NewEthereumSignature(checkpoint, EthereumKey(orchestrator))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign outgoing tx"), nil, err
+		}
+
+		signer := EthereumAddress(orchestrator).Hex()
+		var confirmation types.EthereumTxConfirmation
+		switch otx := otx.(type) {
+		case *types.SignerSetTx:
+			confirmation = &types.SignerSetTxConfirmation{
+				SignerSetNonce: otx.Nonce,
+				EthereumSigner: signer,
+				Signature:      signature,
+			}
+		case *types.BatchTx:
+			confirmation = &types.BatchTxConfirmation{
+				TokenContract:  otx.TokenContract,
+				BatchNonce:     otx.BatchNonce,
+				EthereumSigner: signer,
+				Signature:      signature,
+			}
+		case *types.ContractCallTx:
+			confirmation = &types.ContractCallTxConfirmation{
+				InvalidationScope: otx.InvalidationScope,
+				InvalidationNonce: otx.InvalidationNonce,
+				EthereumSigner:    signer,
+				Signature:         signature,
+			}
+		}
+
+		any, err := types.PackConfirmation(confirmation)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to pack confirmation"), nil, err
+		}
+
+		msg := &types.MsgSubmitEthereumTxConfirmation{Confirmation: any, Signer: orchestrator.Address.String()}
+		return deliver(r, app, ctx, cdc, ak, bk, orchestrator, msg, msgType, nil)
+	}
+}
+
+// SimulateMsgSubmitEthereumEvent generates a MsgSubmitEthereumEvent of a random orchestrator for its next event
+// nonce. The orchestrator votes for the event other orchestrators voted for at the nonce, or fabricates a deposit
+// or the execution of a batch if none did.
+func SimulateMsgSubmitEthereumEvent(cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgSubmitEthereumEvent{}).Type()
+
+		orchestrator, _, found := randomOrchestrator(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bonded orchestrator"), nil, nil
+		}
+
+		res, err := k.LastSubmittedEthereumEvent(sdk.WrapSDKContext(ctx), &types.LastSubmittedEthereumEventRequest{
+			Address: orchestrator.Address.String(),
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to query last event nonce"), nil, err
+		}
+		nonce := res.EventNonce + 1
+
+		var event types.EthereumEvent
+		if records := k.GetEthereumEventVoteRecordsByNonce(ctx, nonce); len(records) > 0 {
+			event, err = types.UnpackEvent(mostVotedEthereumEventVoteRecord(records).Event)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to unpack event"), nil, err
+			}
+		} else if nonce <= k.GetLastObservedEventNonce(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "the event at the nonce was pruned"), nil, nil
+		} else {
+			event = fabricateEthereumEvent(r, ctx, k, bk, accs, nonce)
+		}
+
+		any, err := types.PackEvent(event)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to pack event"), nil, err
+		}
+
+		msg := &types.MsgSubmitEthereumEvent{Event: any, Signer: orchestrator.Address.String()}
+		return deliver(r, app, ctx, cdc, ak, bk, orchestrator, msg, msgType, nil)
+	}
+}
+
+// fabricateEthereumEvent returns the execution of a random batch, a deposit of a random ethereum originated
+// ERC20 or the return of the bond denom if it is bridged
+func fabricateEthereumEvent(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, bk types.BankKeeper, accs []simtypes.Account, nonce uint64,
+) types.EthereumEvent {
+	height := k.GetLastObservedEthereumBlockHeight(ctx).EthereumHeight + uint64(simtypes.RandIntBetween(r, 1, 10))
+
+	var batches []*types.BatchTx
+	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		batches = append(batches, otx.(*types.BatchTx))
+		return false
+	})
+	if len(batches) > 0 && r.Intn(2) == 0 {
+		batch := batches[r.Intn(len(batches))]
+		return &types.BatchExecutedEvent{
+			EventNonce:     nonce,
+			TokenContract:  batch.TokenContract,
+			BatchNonce:     batch.BatchNonce,
+			EthereumHeight: height,
+		}
+	}
+
+	sender, _ := simtypes.RandomAcc(r, accs)
+	receiver, _ := simtypes.RandomAcc(r, accs)
+	event := &types.SendToCosmosEvent{
+		EventNonce:     nonce,
+		TokenContract:  TokenContracts[r.Intn(len(TokenContracts))].Hex(),
+		Amount:         simtypes.RandomAmount(r, sdk.NewInt(1_000_000_000_000)),
+		EthereumSender: EthereumAddress(sender).Hex(),
+		CosmosReceiver: receiver.Address.String(),
+		EthereumHeight: height,
+	}
+
+	// the bond denom held by the module account for the sends to ethereum can be returned
+	if isCosmosOriginated, _ := k.ERC20ToDenomLookup(ctx, BondDenomContract.Hex()); isCosmosOriginated && r.Intn(4) == 0 {
+		escrowed := bk.SpendableCoins(ctx, authtypes.NewModuleAddress(types.ModuleName)).AmountOf(sdk.DefaultBondDenom)
+		event.TokenContract = BondDenomContract.Hex()
+		event.Amount = simtypes.RandomAmount(r, escrowed)
+	}
+
+	return event
+}
+
+// mostVotedEthereumEventVoteRecord returns the accepted record, or the record with the most vote power
+func mostVotedEthereumEventVoteRecord(records []*types.EthereumEventVoteRecord) *types.EthereumEventVoteRecord {
+	mostVoted := records[0]
+	for _, record := range records {
+		if record.Accepted {
+			return record
+		}
+		if record.VotePower.GT(mostVoted.VotePower) {
+			mostVoted = record
+		}
+	}
+	return mostVoted
+}
+
+// randomOrchestrator returns a random simulated account that orchestrates for a bonded validator with its
+// ethereum key
+func randomOrchestrator(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account,
+) (simtypes.Account, sdk.ValAddress, bool) {
+	offset := r.Intn(len(accs))
+	for i := range accs {
+		acc := accs[(offset+i)%len(accs)]
+		val := k.GetOrchestratorValidatorAddress(ctx, acc.Address)
+		if val == nil || k.GetValidatorEthereumAddress(ctx, val) != EthereumAddress(acc) {
+			continue
+		}
+		if validator := k.StakingKeeper.Validator(ctx, val); validator != nil && validator.IsBonded() {
+			return acc, val, true
+		}
+	}
+	return simtypes.Account{}, nil, false
+}
+
+// deliver generates a tx for the msg with random fees and delivers it, the msg is logged in its proto JSON
+// encoding as the gravity msgs have no amino sign bytes
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper,
+	simAccount simtypes.Account, msg sdk.Msg, msgType string, spent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	account := ak.GetAccount(ctx, simAccount.Address)
+	coins, hasNeg := bk.SpendableCoins(ctx, account.GetAddress()).SafeSub(spent)
+	if hasNeg {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "message doesn't leave room for fees"), nil, nil
+	}
+
+	fees, err := simtypes.RandomFees(r, ctx, coins)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate fees"), nil, err
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		ctx.ChainID(),
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate mock tx"), nil, err
+	}
+
+	if _, _, err := app.Deliver(txGen.TxEncoder(), tx); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsgBasic(types.RouterKey, msgType, "", true, cdc.MustMarshalJSON(msg)), nil, nil
+}
@@ -0,0 +1,50 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamsStoreKeySignedBatchesWindow),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenSignedTxsWindow(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamsStoreKeyTargetEthTxTimeout),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenTargetEthTxTimeout(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamsStoreKeyEventVoteThreshold),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenEventVoteThreshold(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamsStoreKeyBatchCreationInterval),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenBatchCreationInterval(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamsStoreKeyMaxBatchSize),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMaxBatchSize(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamsStoreKeyMaxBatchGas),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMaxBatchGas(r))
+			},
+		),
+	}
+}
@@ -0,0 +1,44 @@
+package simulation
+
+import (
+	"math/rand"
+
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// OpWeightSubmitBridgePauseProposal app params key for bridge pause proposal
+const OpWeightSubmitBridgePauseProposal = "op_weight_submit_bridge_pause_proposal"
+
+// ProposalContents defines the module weighted proposals' contents
+func ProposalContents() []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitBridgePauseProposal,
+			simappparams.DefaultWeightTextProposal,
+			SimulateBridgePauseProposalContent,
+		),
+	}
+}
+
+// SimulateBridgePauseProposalContent generates random bridge pause proposal content, which pauses or
+// resumes the bridge for one of the simulated ERC20s or for every token
+func SimulateBridgePauseProposalContent(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) simtypes.Content {
+	var tokens []string
+	global := r.Intn(4) == 0
+	if !global {
+		tokens = []string{TokenContracts[r.Intn(len(TokenContracts))].Hex()}
+	}
+
+	return types.NewBridgePauseProposal(
+		simtypes.RandStringOfLength(r, 10),
+		simtypes.RandStringOfLength(r, 100),
+		r.Intn(2) == 0,
+		global,
+		tokens,
+	)
+}
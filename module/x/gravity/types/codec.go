@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the vesting interfaces and concrete types on the
@@ -39,11 +40,21 @@ func init() {
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSendToEthereum{},
+		&MsgSendToEthereumMulti{},
 		&MsgCancelSendToEthereum{},
+		&MsgIncreaseSendToEthereumFee{},
 		&MsgRequestBatchTx{},
 		&MsgSubmitEthereumEvent{},
 		&MsgSubmitEthereumTxConfirmation{},
 		&MsgDelegateKeys{},
+		&MsgSubmitBadSignatureEvidence{},
+		&MsgCancelPendingSendToEthereum{},
+	)
+
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&ResumeBridgeProposal{},
+		&BridgePauseProposal{},
+		&CancelPendingSendToEthereumProposal{},
 	)
 
 	registry.RegisterInterface(
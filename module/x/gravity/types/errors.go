@@ -10,4 +10,7 @@ var (
 	ErrDelegateKeys      = sdkerrors.Register(ModuleName, 5, "failed to delegate keys")
 	ErrEmptyEthSig       = sdkerrors.Register(ModuleName, 6, "empty Ethereum signature")
 	ErrInvalidERC20Event = sdkerrors.Register(ModuleName, 7, "invalid ERC20 deployed event")
+	ErrBridgeHalted      = sdkerrors.Register(ModuleName, 8, "bridge is halted")
+	ErrBridgePaused      = sdkerrors.Register(ModuleName, 9, "bridge is paused")
+	ErrOutflowRateLimit  = sdkerrors.Register(ModuleName, 10, "send to ethereum exceeds the outflow rate limit")
 )
@@ -24,11 +24,24 @@ func NewEthereumSignature(hash []byte, privateKey *ecdsa.PrivateKey) ([]byte, er
 // ValidateEthereumSignature takes a message, an associated signature and public key and
 // returns an error if the signature isn't valid
 func ValidateEthereumSignature(hash []byte, signature []byte, ethAddress common.Address) error {
+	addr, err := EthereumAddressFromSignature(hash, signature)
+	if err != nil {
+		return err
+	}
+
+	if addr != ethAddress {
+		return sdkerrors.Wrapf(ErrInvalid, "signature not matching addr %x sig %x hash %x", addr, signature, hash)
+	}
+
+	return nil
+}
+
+// EthereumAddressFromSignature recovers the ethereum address that produced the signature over a message
+func EthereumAddressFromSignature(hash []byte, signature []byte) (common.Address, error) {
 
 	/// signature to public key: invalid signature length: invalid
-	/// signature not matching: invalid: invalid
 	if len(signature) < 65 {
-		return sdkerrors.Wrapf(ErrInvalid, "signature too short signature %x", signature)
+		return common.Address{}, sdkerrors.Wrapf(ErrInvalid, "signature too short signature %x", signature)
 	}
 
 	// Copy to avoid mutating signature slice by accident
@@ -57,12 +70,8 @@ func ValidateEthereumSignature(hash []byte, signature []byte, ethAddress common.
 
 	pubkey, err := crypto.SigToPub(crypto.Keccak256Hash(hash).Bytes(), sigCopy)
 	if err != nil {
-		return sdkerrors.Wrapf(err, "signature to public key sig %x hash %x", sigCopy, hash)
-	}
-
-	if addr := crypto.PubkeyToAddress(*pubkey); addr != ethAddress {
-		return sdkerrors.Wrapf(ErrInvalid, "signature not matching addr %x sig %x hash %x", addr, signature, hash)
+		return common.Address{}, sdkerrors.Wrapf(err, "signature to public key sig %x hash %x", sigCopy, hash)
 	}
 
-	return nil
+	return crypto.PubkeyToAddress(*pubkey), nil
 }
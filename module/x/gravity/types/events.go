@@ -1,14 +1,24 @@
 package types
 
 const (
-	EventTypeObservation              = "observation"
-	EventTypeOutgoingBatch            = "outgoing_batch"
-	EventTypeMultisigUpdateRequest    = "multisig_update_request"
-	EventTypeOutgoingBatchCanceled    = "outgoing_batch_canceled"
-	EventTypeContractCallTxCanceled   = "outgoing_logic_call_canceled"
-	EventTypeBridgeWithdrawalReceived = "withdrawal_received"
-	EventTypeBridgeDepositReceived    = "deposit_received"
-	EventTypeBridgeWithdrawCanceled   = "withdraw_canceled"
+	EventTypeObservation                = "observation"
+	EventTypeOutgoingBatch              = "outgoing_batch"
+	EventTypeMultisigUpdateRequest      = "multisig_update_request"
+	EventTypeOutgoingBatchCanceled      = "outgoing_batch_canceled"
+	EventTypeContractCallTxCanceled     = "outgoing_logic_call_canceled"
+	EventTypeBridgeWithdrawalReceived   = "withdrawal_received"
+	EventTypeBridgeDepositReceived      = "deposit_received"
+	EventTypeBridgeWithdrawCanceled     = "withdraw_canceled"
+	EventTypeConflictingEventVote       = "conflicting_ethereum_event_vote"
+	EventTypeBadSignatureEvidence       = "bad_signature_evidence"
+	EventTypeBridgeHalted               = "bridge_halted"
+	EventTypeBridgeResumed              = "bridge_resumed"
+	EventTypeBridgePause                = "bridge_pause"
+	EventTypeSendToCosmosParked         = "send_to_cosmos_parked"
+	EventTypeSendToEthereumPending      = "send_to_ethereum_pending"
+	EventTypeSendToEthereumReleased     = "send_to_ethereum_released"
+	EventTypeSendToEthereumFeeIncreased = "send_to_ethereum_fee_increased"
+	EventTypeSendToEthereumExpired      = "send_to_ethereum_expired"
 
 	AttributeKeyEthereumEventVoteRecordID = "ethereum_event_vote_record_id"
 	AttributeKeyBatchConfirmKey           = "batch_confirm_key"
@@ -19,6 +29,14 @@ const (
 	AttributeKeyContract                      = "bridge_contract"
 	AttributeKeyNonce                         = "nonce"
 	AttributeKeySignerSetNonce                = "signerset_nonce"
+	AttributeKeyExpectedSignerSetHash         = "expected_signerset_hash"
+	AttributeKeyObservedSignerSetHash         = "observed_signerset_hash"
+	AttributeKeyPaused                        = "paused"
+	AttributeKeyTokenContract                 = "token_contract"
+	AttributeKeyReleaseHeight                 = "release_height"
+	AttributeKeyExpiresAtHeight               = "expires_at_height"
+	AttributeKeyOldFee                        = "old_fee"
+	AttributeKeyNewFee                        = "new_fee"
 	AttributeKeyBatchNonce                    = "batch_nonce"
 	AttributeKeyBridgeChainID                 = "bridge_chain_id"
 	AttributeKeySetOrchestratorAddr           = "set_orchestrator_address"
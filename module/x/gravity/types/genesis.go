@@ -2,7 +2,10 @@ package types
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"math"
+	"reflect"
 	"time"
 
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -10,6 +13,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
 )
 
 // DefaultParamspace defines the default auth module parameter subspace
@@ -65,6 +69,63 @@ var (
 	//  ParamStoreUnbondSlashingSignerSetTxsWindow stores unbond slashing valset window
 	ParamStoreUnbondSlashingSignerSetTxsWindow = []byte("UnbondSlashingSignerSetTxsWindow")
 
+	// ParamsStoreSlashFractionBadEthereumSignature stores the slash fraction for signing a fake checkpoint
+	ParamsStoreSlashFractionBadEthereumSignature = []byte("SlashFractionBadEthereumSignature")
+
+	// ParamsStoreKeySignedContractCallTxsWindow stores the signed contract call txs window
+	ParamsStoreKeySignedContractCallTxsWindow = []byte("SignedContractCallTxsWindow")
+
+	// ParamsStoreSlashFractionContractCallTx stores the slash fraction contract call tx
+	ParamsStoreSlashFractionContractCallTx = []byte("SlashFractionContractCallTx")
+
+	// ParamsStoreKeySigningInfoWindow stores the number of signatures and votes tracked per validator
+	ParamsStoreKeySigningInfoWindow = []byte("SigningInfoWindow")
+
+	// ParamsStoreKeyMinSignedPerWindow stores the minimum ratio of the window a validator must sign
+	ParamsStoreKeyMinSignedPerWindow = []byte("MinSignedPerWindow")
+
+	// ParamsStoreKeyOutflowRateLimits stores the per denom caps on sends to ethereum
+	ParamsStoreKeyOutflowRateLimits = []byte("OutflowRateLimits")
+
+	// ParamsStoreKeyLargeWithdrawalThresholds stores the per denom amounts above which sends to ethereum are held
+	ParamsStoreKeyLargeWithdrawalThresholds = []byte("LargeWithdrawalThresholds")
+
+	// ParamsStoreKeyLargeWithdrawalDelay stores the number of blocks large sends to ethereum are held
+	ParamsStoreKeyLargeWithdrawalDelay = []byte("LargeWithdrawalDelay")
+
+	// ParamsStoreKeyWithdrawalGuardian stores the account allowed to cancel held sends to ethereum
+	ParamsStoreKeyWithdrawalGuardian = []byte("WithdrawalGuardian")
+
+	// ParamsStoreKeyEventVoteThreshold stores the fraction of the voting power needed to observe an ethereum event
+	ParamsStoreKeyEventVoteThreshold = []byte("EventVoteThreshold")
+
+	// ParamsStoreKeyEventVoteThresholds stores the per event type overrides of the event vote threshold
+	ParamsStoreKeyEventVoteThresholds = []byte("EventVoteThresholds")
+
+	// ParamsStoreKeyBatchCreationInterval stores the number of blocks between two batch tx creations
+	ParamsStoreKeyBatchCreationInterval = []byte("BatchCreationInterval")
+
+	// ParamsStoreKeyMaxBatchSize stores the max number of sends to ethereum in a batch tx
+	ParamsStoreKeyMaxBatchSize = []byte("MaxBatchSize")
+
+	// ParamsStoreKeyMinBatchFees stores the per token total fees needed to create a batch tx
+	ParamsStoreKeyMinBatchFees = []byte("MinBatchFees")
+
+	// ParamsStoreKeyMaxOutstandingBatches stores the max number of unexecuted batch txs per token
+	ParamsStoreKeyMaxOutstandingBatches = []byte("MaxOutstandingBatches")
+
+	// ParamsStoreKeyMaxBatchGas stores the max estimated gas of a batch tx
+	ParamsStoreKeyMaxBatchGas = []byte("MaxBatchGas")
+
+	// ParamsStoreKeyBatchBaseGas stores the estimated gas of a batch tx without transfers
+	ParamsStoreKeyBatchBaseGas = []byte("BatchBaseGas")
+
+	// ParamsStoreKeyBatchTransferGas stores the estimated gas of a transfer in a batch tx
+	ParamsStoreKeyBatchTransferGas = []byte("BatchTransferGas")
+
+	// ParamsStoreKeyTokenTransferGas stores the per token overrides of the batch transfer gas
+	ParamsStoreKeyTokenTransferGas = []byte("TokenTransferGas")
+
 	// Ensure that params implements the proper interface
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -90,16 +151,273 @@ func (gs *GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
 	return nil
 }
 
-func EventVoteRecordPowerThreshold(totalPower sdk.Int) sdk.Int {
-	return sdk.NewInt(66).Mul(totalPower).Quo(sdk.NewInt(100))
+// EventVoteRecordPowerThreshold returns the power an event vote record needs to be observed
+func EventVoteRecordPowerThreshold(totalPower sdk.Int, threshold sdk.Dec) sdk.Int {
+	return threshold.MulInt(totalPower).TruncateInt()
+}
+
+// EventVoteThresholdOf returns the fraction of the voting power needed to observe an event of the event type
+func (p Params) EventVoteThresholdOf(eventType string) sdk.Dec {
+	for _, threshold := range p.EventVoteThresholds {
+		if threshold.EventType == eventType {
+			return threshold.Threshold
+		}
+	}
+	return p.EventVoteThreshold
+}
+
+// MinBatchFeeOf returns the total fee the sends to ethereum of a batch tx of the token contract must pay
+func (p Params) MinBatchFeeOf(tokenContract common.Address) sdk.Int {
+	for _, fee := range p.MinBatchFees {
+		if common.HexToAddress(fee.TokenContract) == tokenContract {
+			return fee.Amount
+		}
+	}
+	return sdk.ZeroInt()
+}
+
+// TransferGasOf returns the estimated gas of a transfer of the token contract in a batch tx
+func (p Params) TransferGasOf(tokenContract common.Address) uint64 {
+	for _, gas := range p.TokenTransferGas {
+		if common.HexToAddress(gas.TokenContract) == tokenContract {
+			return gas.TransferGas
+		}
+	}
+	return p.BatchTransferGas
+}
+
+// BatchTxGas returns the estimated gas of a batch tx of the token contract with the number of transfers
+func (p Params) BatchTxGas(tokenContract common.Address, transfers int) uint64 {
+	return p.BatchBaseGas + uint64(transfers)*p.TransferGasOf(tokenContract)
+}
+
+// MaxBatchTransfers returns the number of transfers of the token contract that fit in the max batch gas,
+// a batch tx always has room for one. Transfers without gas, which the params validation rejects, don't
+// bound the number of transfers.
+func (p Params) MaxBatchTransfers(tokenContract common.Address) int {
+	transferGas := p.TransferGasOf(tokenContract)
+	if transferGas == 0 {
+		return math.MaxInt32
+	}
+	if p.MaxBatchGas < p.BatchBaseGas+transferGas {
+		return 1
+	}
+	return int((p.MaxBatchGas - p.BatchBaseGas) / transferGas)
 }
 
 // ValidateBasic validates genesis state by looping through the params and
-// calling their validation functions
+// calling their validation functions, then checks that the outgoing txs,
+// confirmations, event vote records, sends and delegate keys are consistent
+// with each other so InitGenesis doesn't fail on them
 func (s GenesisState) ValidateBasic() error {
+	if s.Params == nil {
+		return sdkerrors.Wrap(ErrInvalid, "params must be set")
+	}
 	if err := s.Params.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "params")
 	}
+
+	storeIndexes, batchedIDs, err := s.validateOutgoingTxs()
+	if err != nil {
+		return sdkerrors.Wrap(err, "outgoing txs")
+	}
+	ethereumSigners, err := s.validateDelegateKeys()
+	if err != nil {
+		return sdkerrors.Wrap(err, "delegate keys")
+	}
+	if err := s.validateConfirmations(storeIndexes, ethereumSigners); err != nil {
+		return sdkerrors.Wrap(err, "confirmations")
+	}
+	if err := s.validateEthereumEventVoteRecords(); err != nil {
+		return sdkerrors.Wrap(err, "ethereum event vote records")
+	}
+	if err := s.validateSendToEthereums(batchedIDs); err != nil {
+		return sdkerrors.Wrap(err, "sends to ethereum")
+	}
+	if err := s.validateERC20Escrows(); err != nil {
+		return sdkerrors.Wrap(err, "erc20 escrows")
+	}
+	return nil
+}
+
+// validateOutgoingTxs returns the store indexes of the outgoing txs and the ids of the sends in batches
+func (s GenesisState) validateOutgoingTxs() (map[string]bool, map[uint64]bool, error) {
+	storeIndexes := make(map[string]bool)
+	batchedIDs := make(map[uint64]bool)
+	for i, ota := range s.OutgoingTxs {
+		otx, err := UnpackOutgoingTx(ota)
+		if err != nil {
+			return nil, nil, sdkerrors.Wrapf(err, "%d", i)
+		}
+		if err := otx.Validate(); err != nil {
+			return nil, nil, sdkerrors.Wrapf(err, "%d", i)
+		}
+
+		storeIndex := string(otx.GetStoreIndex())
+		if storeIndexes[storeIndex] {
+			return nil, nil, sdkerrors.Wrapf(ErrInvalid, "%d: duplicate outgoing tx %T", i, otx)
+		}
+		storeIndexes[storeIndex] = true
+
+		if btx, ok := otx.(*BatchTx); ok {
+			for _, ste := range btx.Transactions {
+				if batchedIDs[ste.Id] {
+					return nil, nil, sdkerrors.Wrapf(ErrInvalid, "send to ethereum %d is in more than one batch", ste.Id)
+				}
+				batchedIDs[ste.Id] = true
+			}
+		}
+	}
+	return storeIndexes, batchedIDs, nil
+}
+
+// validateDelegateKeys checks that every validator, orchestrator and ethereum address is delegated
+// at most once, and returns the delegated ethereum addresses
+func (s GenesisState) validateDelegateKeys() (map[common.Address]bool, error) {
+	validators := make(map[string]bool)
+	orchestrators := make(map[string]bool)
+	ethereumSigners := make(map[common.Address]bool)
+	for _, keys := range s.DelegateKeys {
+		// the signature that registered the keys isn't kept in state, so exported keys don't have one
+		if err := keys.ValidateBasic(); err != nil && !errors.Is(err, ErrEmptyEthSig) {
+			return nil, err
+		}
+
+		val, _ := sdk.ValAddressFromBech32(keys.ValidatorAddress)
+		orch, _ := sdk.AccAddressFromBech32(keys.OrchestratorAddress)
+		eth := common.HexToAddress(keys.EthereumAddress)
+		switch {
+		case validators[val.String()]:
+			return nil, sdkerrors.Wrapf(ErrInvalid, "validator %s has more than one delegate key", val)
+		case orchestrators[orch.String()]:
+			return nil, sdkerrors.Wrapf(ErrInvalid, "orchestrator %s is delegated more than once", orch)
+		case ethereumSigners[eth]:
+			return nil, sdkerrors.Wrapf(ErrInvalid, "ethereum address %s is delegated more than once", eth)
+		}
+		validators[val.String()] = true
+		orchestrators[orch.String()] = true
+		ethereumSigners[eth] = true
+	}
+	return ethereumSigners, nil
+}
+
+// validateConfirmations checks that every confirmation is for an exported outgoing tx and was made
+// by a delegated ethereum address
+func (s GenesisState) validateConfirmations(storeIndexes map[string]bool, ethereumSigners map[common.Address]bool) error {
+	for i, confa := range s.Confirmations {
+		conf, err := UnpackConfirmation(confa)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "%d", i)
+		}
+		if err := conf.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "%d", i)
+		}
+		if !storeIndexes[string(conf.GetStoreIndex())] {
+			return sdkerrors.Wrapf(ErrInvalid, "%d: %T is not for an exported outgoing tx", i, conf)
+		}
+		if !ethereumSigners[conf.GetSigner()] {
+			return sdkerrors.Wrapf(ErrInvalid, "%d: ethereum signer %s has no delegate keys", i, conf.GetSigner())
+		}
+	}
+	return nil
+}
+
+// validateEthereumEventVoteRecords checks the events and voters of the vote records, and that only the
+// events up to the last observed event nonce were observed, once per nonce
+func (s GenesisState) validateEthereumEventVoteRecords() error {
+	records := make(map[string]bool)
+	observed := make(map[uint64]bool)
+	for i, record := range s.EthereumEventVoteRecords {
+		event, err := UnpackEvent(record.Event)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "%d", i)
+		}
+		if err := event.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "%d", i)
+		}
+		for _, vote := range record.Votes {
+			if _, err := sdk.ValAddressFromBech32(vote.Validator); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%d: voter %s", i, vote.Validator)
+			}
+		}
+
+		nonce := event.GetEventNonce()
+		key := string(MakeEthereumEventVoteRecordKey(nonce, event.Hash()))
+		if records[key] {
+			return sdkerrors.Wrapf(ErrInvalid, "%d: duplicate vote record for event nonce %d", i, nonce)
+		}
+		records[key] = true
+
+		if !record.Accepted {
+			continue
+		}
+		if nonce > s.LastObservedEventNonce {
+			return sdkerrors.Wrapf(ErrInvalid, "%d: event nonce %d observed after the last observed event nonce %d", i, nonce, s.LastObservedEventNonce)
+		}
+		if observed[nonce] {
+			return sdkerrors.Wrapf(ErrInvalid, "%d: more than one event observed at nonce %d", i, nonce)
+		}
+		observed[nonce] = true
+	}
+
+	for _, summary := range s.EthereumEventSummaries {
+		if summary.EventNonce > s.LastObservedEventNonce {
+			return sdkerrors.Wrapf(ErrInvalid, "summary of event nonce %d after the last observed event nonce %d", summary.EventNonce, s.LastObservedEventNonce)
+		}
+		if observed[summary.EventNonce] {
+			return sdkerrors.Wrapf(ErrInvalid, "more than one event observed at nonce %d", summary.EventNonce)
+		}
+		observed[summary.EventNonce] = true
+	}
+	return nil
+}
+
+// validateSendToEthereums checks that the unbatched and held sends have unique ids that aren't in a batch
+func (s GenesisState) validateSendToEthereums(batchedIDs map[uint64]bool) error {
+	ids := make(map[uint64]bool)
+	check := func(ste *SendToEthereum) error {
+		if err := ste.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "send to ethereum %d", ste.Id)
+		}
+		if batchedIDs[ste.Id] {
+			return sdkerrors.Wrapf(ErrInvalid, "send to ethereum %d is also in a batch", ste.Id)
+		}
+		if ids[ste.Id] {
+			return sdkerrors.Wrapf(ErrInvalid, "duplicate send to ethereum %d", ste.Id)
+		}
+		ids[ste.Id] = true
+		return nil
+	}
+
+	for _, ste := range s.UnbatchedSendToEthereumTxs {
+		if err := check(ste); err != nil {
+			return err
+		}
+	}
+	for i := range s.PendingSendToEthereums {
+		if err := check(&s.PendingSendToEthereums[i].SendToEthereum); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateERC20Escrows checks that every ERC20 has at most one positive escrow
+func (s GenesisState) validateERC20Escrows() error {
+	contracts := make(map[common.Address]bool)
+	for _, escrow := range s.Erc20Escrows {
+		if !common.IsHexAddress(escrow.Contract) {
+			return sdkerrors.Wrapf(ErrInvalid, "contract %s is not a hex address", escrow.Contract)
+		}
+		if escrow.Amount.IsNil() || !escrow.Amount.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalid, "escrow of %s must be positive", escrow.Contract)
+		}
+		contract := common.HexToAddress(escrow.Contract)
+		if contracts[contract] {
+			return sdkerrors.Wrapf(ErrInvalid, "duplicate escrow of %s", escrow.Contract)
+		}
+		contracts[contract] = true
+	}
 	return nil
 }
 
@@ -127,6 +445,24 @@ func DefaultParams() *Params {
 		SlashFractionEthereumSignature:            sdk.NewDec(1).Quo(sdk.NewDec(1000)),
 		SlashFractionConflictingEthereumSignature: sdk.NewDec(1).Quo(sdk.NewDec(1000)),
 		UnbondSlashingSignerSetTxsWindow:          10000,
+		SlashFractionBadEthereumSignature:         sdk.NewDec(1).Quo(sdk.NewDec(20)),
+		SignedContractCallTxsWindow:               10000,
+		SlashFractionContractCallTx:               sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		SigningInfoWindow:                         100,
+		MinSignedPerWindow:                        sdk.NewDecWithPrec(5, 1),
+		OutflowRateLimits:                         []OutflowRateLimit{},
+		LargeWithdrawalThresholds:                 []LargeWithdrawalThreshold{},
+		LargeWithdrawalDelay:                      86400,
+		EventVoteThreshold:                        sdk.NewDecWithPrec(66, 2),
+		EventVoteThresholds:                       []EventVoteThreshold{},
+		BatchCreationInterval:                     10,
+		MaxBatchSize:                              100,
+		MinBatchFees:                              []MinBatchFee{},
+		MaxOutstandingBatches:                     0,
+		MaxBatchGas:                               5000000,
+		BatchBaseGas:                              500000,
+		BatchTransferGas:                          45000,
+		TokenTransferGas:                          []TokenTransferGas{},
 	}
 }
 
@@ -177,6 +513,71 @@ func (p Params) ValidateBasic() error {
 	if err := validateUnbondSlashingSignerSetTxsWindow(p.UnbondSlashingSignerSetTxsWindow); err != nil {
 		return sdkerrors.Wrap(err, "unbond slashing signersettx window")
 	}
+	if err := validateSlashFractionBadEthereumSignature(p.SlashFractionBadEthereumSignature); err != nil {
+		return sdkerrors.Wrap(err, "slash fraction bad ethereum signature")
+	}
+	if err := validateSignedContractCallTxsWindow(p.SignedContractCallTxsWindow); err != nil {
+		return sdkerrors.Wrap(err, "signed contract call txs window")
+	}
+	if err := validateSlashFractionContractCallTx(p.SlashFractionContractCallTx); err != nil {
+		return sdkerrors.Wrap(err, "slash fraction contract call tx")
+	}
+	if err := validateSigningInfoWindow(p.SigningInfoWindow); err != nil {
+		return sdkerrors.Wrap(err, "signing info window")
+	}
+	if err := validateMinSignedPerWindow(p.MinSignedPerWindow); err != nil {
+		return sdkerrors.Wrap(err, "min signed per window")
+	}
+	if err := validateOutflowRateLimits(p.OutflowRateLimits); err != nil {
+		return sdkerrors.Wrap(err, "outflow rate limits")
+	}
+	if err := validateLargeWithdrawalThresholds(p.LargeWithdrawalThresholds); err != nil {
+		return sdkerrors.Wrap(err, "large withdrawal thresholds")
+	}
+	if err := validateLargeWithdrawalDelay(p.LargeWithdrawalDelay); err != nil {
+		return sdkerrors.Wrap(err, "large withdrawal delay")
+	}
+	if err := validateWithdrawalGuardian(p.WithdrawalGuardian); err != nil {
+		return sdkerrors.Wrap(err, "withdrawal guardian")
+	}
+	if err := validateEventVoteThreshold(p.EventVoteThreshold); err != nil {
+		return sdkerrors.Wrap(err, "event vote threshold")
+	}
+	if err := validateEventVoteThresholds(p.EventVoteThresholds); err != nil {
+		return sdkerrors.Wrap(err, "event vote thresholds")
+	}
+	if err := validateBatchCreationInterval(p.BatchCreationInterval); err != nil {
+		return sdkerrors.Wrap(err, "batch creation interval")
+	}
+	if err := validateMaxBatchSize(p.MaxBatchSize); err != nil {
+		return sdkerrors.Wrap(err, "max batch size")
+	}
+	if err := validateMinBatchFees(p.MinBatchFees); err != nil {
+		return sdkerrors.Wrap(err, "min batch fees")
+	}
+	if err := validateMaxOutstandingBatches(p.MaxOutstandingBatches); err != nil {
+		return sdkerrors.Wrap(err, "max outstanding batches")
+	}
+	if err := validateMaxBatchGas(p.MaxBatchGas); err != nil {
+		return sdkerrors.Wrap(err, "max batch gas")
+	}
+	if err := validateBatchBaseGas(p.BatchBaseGas); err != nil {
+		return sdkerrors.Wrap(err, "batch base gas")
+	}
+	if err := validateBatchTransferGas(p.BatchTransferGas); err != nil {
+		return sdkerrors.Wrap(err, "batch transfer gas")
+	}
+	if err := validateTokenTransferGas(p.TokenTransferGas); err != nil {
+		return sdkerrors.Wrap(err, "token transfer gas")
+	}
+	if p.MaxBatchGas < p.BatchBaseGas+p.BatchTransferGas {
+		return sdkerrors.Wrap(ErrInvalid, "max batch gas must fit a batch tx with one transfer")
+	}
+	for _, gas := range p.TokenTransferGas {
+		if p.MaxBatchGas < p.BatchBaseGas+gas.TransferGas {
+			return sdkerrors.Wrapf(ErrInvalid, "max batch gas must fit a batch tx with one transfer of %s", gas.TokenContract)
+		}
+	}
 
 	return nil
 }
@@ -205,6 +606,25 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamsStoreSlashFractionEthereumSignature, &p.SlashFractionEthereumSignature, validateSlashFractionEthereumSignature),
 		paramtypes.NewParamSetPair(ParamsStoreSlashFractionConflictingEthereumSignature, &p.SlashFractionConflictingEthereumSignature, validateSlashFractionConflictingEthereumSignature),
 		paramtypes.NewParamSetPair(ParamStoreUnbondSlashingSignerSetTxsWindow, &p.UnbondSlashingSignerSetTxsWindow, validateUnbondSlashingSignerSetTxsWindow),
+		paramtypes.NewParamSetPair(ParamsStoreSlashFractionBadEthereumSignature, &p.SlashFractionBadEthereumSignature, validateSlashFractionBadEthereumSignature),
+		paramtypes.NewParamSetPair(ParamsStoreKeySignedContractCallTxsWindow, &p.SignedContractCallTxsWindow, validateSignedContractCallTxsWindow),
+		paramtypes.NewParamSetPair(ParamsStoreSlashFractionContractCallTx, &p.SlashFractionContractCallTx, validateSlashFractionContractCallTx),
+		paramtypes.NewParamSetPair(ParamsStoreKeySigningInfoWindow, &p.SigningInfoWindow, validateSigningInfoWindow),
+		paramtypes.NewParamSetPair(ParamsStoreKeyMinSignedPerWindow, &p.MinSignedPerWindow, validateMinSignedPerWindow),
+		paramtypes.NewParamSetPair(ParamsStoreKeyOutflowRateLimits, &p.OutflowRateLimits, validateOutflowRateLimits),
+		paramtypes.NewParamSetPair(ParamsStoreKeyLargeWithdrawalThresholds, &p.LargeWithdrawalThresholds, validateLargeWithdrawalThresholds),
+		paramtypes.NewParamSetPair(ParamsStoreKeyLargeWithdrawalDelay, &p.LargeWithdrawalDelay, validateLargeWithdrawalDelay),
+		paramtypes.NewParamSetPair(ParamsStoreKeyWithdrawalGuardian, &p.WithdrawalGuardian, validateWithdrawalGuardian),
+		paramtypes.NewParamSetPair(ParamsStoreKeyEventVoteThreshold, &p.EventVoteThreshold, validateEventVoteThreshold),
+		paramtypes.NewParamSetPair(ParamsStoreKeyEventVoteThresholds, &p.EventVoteThresholds, validateEventVoteThresholds),
+		paramtypes.NewParamSetPair(ParamsStoreKeyBatchCreationInterval, &p.BatchCreationInterval, validateBatchCreationInterval),
+		paramtypes.NewParamSetPair(ParamsStoreKeyMaxBatchSize, &p.MaxBatchSize, validateMaxBatchSize),
+		paramtypes.NewParamSetPair(ParamsStoreKeyMinBatchFees, &p.MinBatchFees, validateMinBatchFees),
+		paramtypes.NewParamSetPair(ParamsStoreKeyMaxOutstandingBatches, &p.MaxOutstandingBatches, validateMaxOutstandingBatches),
+		paramtypes.NewParamSetPair(ParamsStoreKeyMaxBatchGas, &p.MaxBatchGas, validateMaxBatchGas),
+		paramtypes.NewParamSetPair(ParamsStoreKeyBatchBaseGas, &p.BatchBaseGas, validateBatchBaseGas),
+		paramtypes.NewParamSetPair(ParamsStoreKeyBatchTransferGas, &p.BatchTransferGas, validateBatchTransferGas),
+		paramtypes.NewParamSetPair(ParamsStoreKeyTokenTransferGas, &p.TokenTransferGas, validateTokenTransferGas),
 	}
 }
 
@@ -353,6 +773,268 @@ func validateSlashFractionConflictingEthereumSignature(i interface{}) error {
 	return nil
 }
 
+func validateSlashFractionBadEthereumSignature(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateSignedContractCallTxsWindow(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		return fmt.Errorf("signed contract call txs window must be positive")
+	}
+	return nil
+}
+
+func validateSlashFractionContractCallTx(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateSigningInfoWindow(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		return fmt.Errorf("signing info window must be positive")
+	}
+	return nil
+}
+
+func validateMinSignedPerWindow(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("min signed per window must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateOutflowRateLimits(i interface{}) error {
+	v, ok := i.([]OutflowRateLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, limit := range v {
+		if err := sdk.ValidateDenom(limit.Denom); err != nil {
+			return err
+		}
+		if seen[limit.Denom] {
+			return fmt.Errorf("duplicate outflow rate limit for %s", limit.Denom)
+		}
+		seen[limit.Denom] = true
+
+		if limit.MaxAmount.IsNil() || limit.MaxAmount.IsNegative() {
+			return fmt.Errorf("outflow rate limit max amount of %s must not be negative", limit.Denom)
+		}
+		if limit.Window == 0 {
+			return fmt.Errorf("outflow rate limit window of %s must be positive", limit.Denom)
+		}
+	}
+	return nil
+}
+
+func validateLargeWithdrawalThresholds(i interface{}) error {
+	v, ok := i.([]LargeWithdrawalThreshold)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, threshold := range v {
+		if err := sdk.ValidateDenom(threshold.Denom); err != nil {
+			return err
+		}
+		if seen[threshold.Denom] {
+			return fmt.Errorf("duplicate large withdrawal threshold for %s", threshold.Denom)
+		}
+		seen[threshold.Denom] = true
+
+		if threshold.Amount.IsNil() || threshold.Amount.IsNegative() {
+			return fmt.Errorf("large withdrawal threshold of %s must not be negative", threshold.Denom)
+		}
+	}
+	return nil
+}
+
+func validateLargeWithdrawalDelay(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateWithdrawalGuardian(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// governance can always cancel held sends, the guardian is optional
+	if v == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateEventVoteThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// at most one event can be observed per nonce only if a majority is needed
+	if v.IsNil() || v.LTE(sdk.NewDecWithPrec(5, 1)) || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("event vote threshold must be above 1/2 and at most 1: %s", v)
+	}
+	return nil
+}
+
+func validateEventVoteThresholds(i interface{}) error {
+	v, ok := i.([]EventVoteThreshold)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	eventType := reflect.TypeOf((*EthereumEvent)(nil)).Elem()
+	seen := make(map[string]bool, len(v))
+	for _, threshold := range v {
+		if t := proto.MessageType(threshold.EventType); t == nil || !t.Implements(eventType) {
+			return fmt.Errorf("unknown ethereum event type %s", threshold.EventType)
+		}
+		if seen[threshold.EventType] {
+			return fmt.Errorf("duplicate event vote threshold for %s", threshold.EventType)
+		}
+		seen[threshold.EventType] = true
+
+		if err := validateEventVoteThreshold(threshold.Threshold); err != nil {
+			return sdkerrors.Wrap(err, threshold.EventType)
+		}
+	}
+	return nil
+}
+
+func validateBatchCreationInterval(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		return fmt.Errorf("batch creation interval must be positive")
+	}
+	return nil
+}
+
+func validateMaxBatchSize(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		return fmt.Errorf("max batch size must be positive")
+	}
+	return nil
+}
+
+func validateMinBatchFees(i interface{}) error {
+	v, ok := i.([]MinBatchFee)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[common.Address]bool, len(v))
+	for _, fee := range v {
+		if !common.IsHexAddress(fee.TokenContract) {
+			return fmt.Errorf("not an ethereum address: %s", fee.TokenContract)
+		}
+		contract := common.HexToAddress(fee.TokenContract)
+		if seen[contract] {
+			return fmt.Errorf("duplicate min batch fee for %s", fee.TokenContract)
+		}
+		seen[contract] = true
+
+		if fee.Amount.IsNil() || fee.Amount.IsNegative() {
+			return fmt.Errorf("min batch fee of %s must not be negative", fee.TokenContract)
+		}
+	}
+	return nil
+}
+
+func validateMaxOutstandingBatches(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxBatchGas(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		return fmt.Errorf("max batch gas must be positive")
+	}
+	return nil
+}
+
+func validateBatchBaseGas(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateBatchTransferGas(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		return fmt.Errorf("batch transfer gas must be positive")
+	}
+	return nil
+}
+
+func validateTokenTransferGas(i interface{}) error {
+	v, ok := i.([]TokenTransferGas)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[common.Address]bool, len(v))
+	for _, gas := range v {
+		if !common.IsHexAddress(gas.TokenContract) {
+			return fmt.Errorf("not an ethereum address: %s", gas.TokenContract)
+		}
+		contract := common.HexToAddress(gas.TokenContract)
+		if seen[contract] {
+			return fmt.Errorf("duplicate token transfer gas for %s", gas.TokenContract)
+		}
+		seen[contract] = true
+
+		if gas.TransferGas == 0 {
+			return fmt.Errorf("transfer gas of %s must be positive", gas.TokenContract)
+		}
+	}
+	return nil
+}
+
 func strToFixByteArray(s string) ([32]byte, error) {
 	var out [32]byte
 	if len([]byte(s)) > 32 {
@@ -57,13 +57,37 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // signed_signer_set_txs_window
 // signed_batches_window
 // signed_ethereum_signatures_window
+// signed_contract_call_txs_window
 //
 // These values represent the time in blocks that a validator has to submit
-// a signature for a batch or valset, or to submit a ethereum_signature for a
+// a signature for a batch, valset or contract call, or to submit a ethereum_signature for a
 // particular attestation nonce. In the case of attestations this clock starts
 // when the attestation is created, but only allows for slashing once the event
 // has passed
 //
+// signing_info_window
+// min_signed_per_window
+//
+// A validator's missed signatures and event votes are tracked over the last
+// signing_info_window outgoing txs and events it was expected to sign. It is
+// only slashed and jailed once the ratio it signed drops below
+// min_signed_per_window
+//
+// outflow_rate_limits
+//
+// The maximum amount of a denom that can be sent to Ethereum over a rolling
+// window of blocks. Denoms without a rate limit are not limited
+//
+// large_withdrawal_thresholds
+// large_withdrawal_delay
+// withdrawal_guardian
+//
+// Sends to Ethereum above the threshold of their denom are held for
+// large_withdrawal_delay blocks before they can be batched. Until then
+// governance, or the withdrawal_guardian account if it is set, can cancel them
+// and refund the sender. The delay has to be longer than the gov voting period
+// for a cancel proposal to pass in time
+//
 // target_eth_tx_timeout:
 //
 // This is the 'target' value for when ethereum transactions time out, this is a target
@@ -82,27 +106,60 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // slash_fraction_batch
 // slash_fraction_ethereum_signature
 // slash_fraction_conflicting_ethereum_signature
+// slash_fraction_bad_ethereum_signature
+// slash_fraction_contract_call_tx
 //
 // The slashing fractions for the various gravity related slashing conditions.
-// The first three refer to not submitting a particular message, the third for
-// submitting a different ethereum_signature for the same Ethereum event
+// The first three and the last refer to not submitting a particular message,
+// the fourth for submitting a different ethereum_signature for the same
+// Ethereum event and the fifth for signing a checkpoint the chain never
+// produced
 type Params struct {
-	GravityId                string `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
-	ContractSourceHash       string `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
-	BridgeEthereumAddress    string `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
-	BridgeChainId            uint64 `protobuf:"varint,5,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
-	SignedSignerSetTxsWindow uint64 `protobuf:"varint,6,opt,name=signed_signer_set_txs_window,json=signedSignerSetTxsWindow,proto3" json:"signed_signer_set_txs_window,omitempty"`
-	SignedBatchesWindow      uint64 `protobuf:"varint,7,opt,name=signed_batches_window,json=signedBatchesWindow,proto3" json:"signed_batches_window,omitempty"`
-	EthereumSignaturesWindow uint64 `protobuf:"varint,8,opt,name=ethereum_signatures_window,json=ethereumSignaturesWindow,proto3" json:"ethereum_signatures_window,omitempty"`
-	TargetEthTxTimeout       uint64 `protobuf:"varint,10,opt,name=target_eth_tx_timeout,json=targetEthTxTimeout,proto3" json:"target_eth_tx_timeout,omitempty"`
-	AverageBlockTime         uint64 `protobuf:"varint,11,opt,name=average_block_time,json=averageBlockTime,proto3" json:"average_block_time,omitempty"`
-	AverageEthereumBlockTime uint64 `protobuf:"varint,12,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
-	// TODO: slash fraction for contract call txs too
+	GravityId                                 string                                 `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
+	ContractSourceHash                        string                                 `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
+	BridgeEthereumAddress                     string                                 `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
+	BridgeChainId                             uint64                                 `protobuf:"varint,5,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
+	SignedSignerSetTxsWindow                  uint64                                 `protobuf:"varint,6,opt,name=signed_signer_set_txs_window,json=signedSignerSetTxsWindow,proto3" json:"signed_signer_set_txs_window,omitempty"`
+	SignedBatchesWindow                       uint64                                 `protobuf:"varint,7,opt,name=signed_batches_window,json=signedBatchesWindow,proto3" json:"signed_batches_window,omitempty"`
+	EthereumSignaturesWindow                  uint64                                 `protobuf:"varint,8,opt,name=ethereum_signatures_window,json=ethereumSignaturesWindow,proto3" json:"ethereum_signatures_window,omitempty"`
+	TargetEthTxTimeout                        uint64                                 `protobuf:"varint,10,opt,name=target_eth_tx_timeout,json=targetEthTxTimeout,proto3" json:"target_eth_tx_timeout,omitempty"`
+	AverageBlockTime                          uint64                                 `protobuf:"varint,11,opt,name=average_block_time,json=averageBlockTime,proto3" json:"average_block_time,omitempty"`
+	AverageEthereumBlockTime                  uint64                                 `protobuf:"varint,12,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
 	SlashFractionSignerSetTx                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=slash_fraction_signer_set_tx,json=slashFractionSignerSetTx,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_signer_set_tx"`
 	SlashFractionBatch                        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_fraction_batch,json=slashFractionBatch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_batch"`
 	SlashFractionEthereumSignature            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=slash_fraction_ethereum_signature,json=slashFractionEthereumSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_ethereum_signature"`
 	SlashFractionConflictingEthereumSignature github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=slash_fraction_conflicting_ethereum_signature,json=slashFractionConflictingEthereumSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_conflicting_ethereum_signature"`
 	UnbondSlashingSignerSetTxsWindow          uint64                                 `protobuf:"varint,17,opt,name=unbond_slashing_signer_set_txs_window,json=unbondSlashingSignerSetTxsWindow,proto3" json:"unbond_slashing_signer_set_txs_window,omitempty"`
+	SlashFractionBadEthereumSignature         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=slash_fraction_bad_ethereum_signature,json=slashFractionBadEthereumSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_bad_ethereum_signature"`
+	SignedContractCallTxsWindow               uint64                                 `protobuf:"varint,19,opt,name=signed_contract_call_txs_window,json=signedContractCallTxsWindow,proto3" json:"signed_contract_call_txs_window,omitempty"`
+	SlashFractionContractCallTx               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=slash_fraction_contract_call_tx,json=slashFractionContractCallTx,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_contract_call_tx"`
+	SigningInfoWindow                         uint64                                 `protobuf:"varint,21,opt,name=signing_info_window,json=signingInfoWindow,proto3" json:"signing_info_window,omitempty"`
+	MinSignedPerWindow                        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,22,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_signed_per_window"`
+	OutflowRateLimits                         []OutflowRateLimit                     `protobuf:"bytes,23,rep,name=outflow_rate_limits,json=outflowRateLimits,proto3" json:"outflow_rate_limits"`
+	LargeWithdrawalThresholds                 []LargeWithdrawalThreshold             `protobuf:"bytes,24,rep,name=large_withdrawal_thresholds,json=largeWithdrawalThresholds,proto3" json:"large_withdrawal_thresholds"`
+	LargeWithdrawalDelay                      uint64                                 `protobuf:"varint,25,opt,name=large_withdrawal_delay,json=largeWithdrawalDelay,proto3" json:"large_withdrawal_delay,omitempty"`
+	WithdrawalGuardian                        string                                 `protobuf:"bytes,26,opt,name=withdrawal_guardian,json=withdrawalGuardian,proto3" json:"withdrawal_guardian,omitempty"`
+	// event_vote_threshold is the fraction of the total voting power that must
+	// vote for an Ethereum event before it is observed, event_vote_thresholds
+	// overrides it for some event types
+	EventVoteThreshold  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,27,opt,name=event_vote_threshold,json=eventVoteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"event_vote_threshold"`
+	EventVoteThresholds []EventVoteThreshold                   `protobuf:"bytes,28,rep,name=event_vote_thresholds,json=eventVoteThresholds,proto3" json:"event_vote_thresholds"`
+	// batch txs are created every batch_creation_interval blocks, with at most
+	// max_batch_size sends, for the tokens whose unbatched sends pay their
+	// min_batch_fees and that have fewer than max_outstanding_batches, unless
+	// zero, batch txs waiting to be executed
+	BatchCreationInterval uint64        `protobuf:"varint,29,opt,name=batch_creation_interval,json=batchCreationInterval,proto3" json:"batch_creation_interval,omitempty"`
+	MaxBatchSize          uint64        `protobuf:"varint,30,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	MinBatchFees          []MinBatchFee `protobuf:"bytes,31,rep,name=min_batch_fees,json=minBatchFees,proto3" json:"min_batch_fees"`
+	MaxOutstandingBatches uint64        `protobuf:"varint,32,opt,name=max_outstanding_batches,json=maxOutstandingBatches,proto3" json:"max_outstanding_batches,omitempty"`
+	// batch txs are filled with sends until their estimated gas, batch_base_gas
+	// plus the transfer gas of each send, would exceed max_batch_gas. The
+	// transfer gas of a token is batch_transfer_gas unless it has an entry in
+	// token_transfer_gas, e.g. for tokens with expensive transfer hooks
+	MaxBatchGas      uint64             `protobuf:"varint,33,opt,name=max_batch_gas,json=maxBatchGas,proto3" json:"max_batch_gas,omitempty"`
+	BatchBaseGas     uint64             `protobuf:"varint,34,opt,name=batch_base_gas,json=batchBaseGas,proto3" json:"batch_base_gas,omitempty"`
+	BatchTransferGas uint64             `protobuf:"varint,35,opt,name=batch_transfer_gas,json=batchTransferGas,proto3" json:"batch_transfer_gas,omitempty"`
+	TokenTransferGas []TokenTransferGas `protobuf:"bytes,36,rep,name=token_transfer_gas,json=tokenTransferGas,proto3" json:"token_transfer_gas"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -215,18 +272,147 @@ func (m *Params) GetUnbondSlashingSignerSetTxsWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetSignedContractCallTxsWindow() uint64 {
+	if m != nil {
+		return m.SignedContractCallTxsWindow
+	}
+	return 0
+}
+
+func (m *Params) GetSigningInfoWindow() uint64 {
+	if m != nil {
+		return m.SigningInfoWindow
+	}
+	return 0
+}
+
+func (m *Params) GetOutflowRateLimits() []OutflowRateLimit {
+	if m != nil {
+		return m.OutflowRateLimits
+	}
+	return nil
+}
+
+func (m *Params) GetLargeWithdrawalThresholds() []LargeWithdrawalThreshold {
+	if m != nil {
+		return m.LargeWithdrawalThresholds
+	}
+	return nil
+}
+
+func (m *Params) GetLargeWithdrawalDelay() uint64 {
+	if m != nil {
+		return m.LargeWithdrawalDelay
+	}
+	return 0
+}
+
+func (m *Params) GetWithdrawalGuardian() string {
+	if m != nil {
+		return m.WithdrawalGuardian
+	}
+	return ""
+}
+
+func (m *Params) GetEventVoteThresholds() []EventVoteThreshold {
+	if m != nil {
+		return m.EventVoteThresholds
+	}
+	return nil
+}
+
+func (m *Params) GetBatchCreationInterval() uint64 {
+	if m != nil {
+		return m.BatchCreationInterval
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBatchSize() uint64 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetMinBatchFees() []MinBatchFee {
+	if m != nil {
+		return m.MinBatchFees
+	}
+	return nil
+}
+
+func (m *Params) GetMaxOutstandingBatches() uint64 {
+	if m != nil {
+		return m.MaxOutstandingBatches
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBatchGas() uint64 {
+	if m != nil {
+		return m.MaxBatchGas
+	}
+	return 0
+}
+
+func (m *Params) GetBatchBaseGas() uint64 {
+	if m != nil {
+		return m.BatchBaseGas
+	}
+	return 0
+}
+
+func (m *Params) GetBatchTransferGas() uint64 {
+	if m != nil {
+		return m.BatchTransferGas
+	}
+	return 0
+}
+
+func (m *Params) GetTokenTransferGas() []TokenTransferGas {
+	if m != nil {
+		return m.TokenTransferGas
+	}
+	return nil
+}
+
 // GenesisState struct
 // TODO: this need to be audited and potentially simplified using the new
 // interfaces
 type GenesisState struct {
-	Params                     *Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	LastObservedEventNonce     uint64                     `protobuf:"varint,2,opt,name=last_observed_event_nonce,json=lastObservedEventNonce,proto3" json:"last_observed_event_nonce,omitempty"`
-	OutgoingTxs                []*types.Any               `protobuf:"bytes,3,rep,name=outgoing_txs,json=outgoingTxs,proto3" json:"outgoing_txs,omitempty"`
-	Confirmations              []*types.Any               `protobuf:"bytes,4,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
-	EthereumEventVoteRecords   []*EthereumEventVoteRecord `protobuf:"bytes,9,rep,name=ethereum_event_vote_records,json=ethereumEventVoteRecords,proto3" json:"ethereum_event_vote_records,omitempty"`
-	DelegateKeys               []*MsgDelegateKeys         `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
-	Erc20ToDenoms              []*ERC20ToDenom            `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
-	UnbatchedSendToEthereumTxs []*SendToEthereum          `protobuf:"bytes,12,rep,name=unbatched_send_to_ethereum_txs,json=unbatchedSendToEthereumTxs,proto3" json:"unbatched_send_to_ethereum_txs,omitempty"`
+	Params                           *Params                     `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	LastObservedEventNonce           uint64                      `protobuf:"varint,2,opt,name=last_observed_event_nonce,json=lastObservedEventNonce,proto3" json:"last_observed_event_nonce,omitempty"`
+	OutgoingTxs                      []*types.Any                `protobuf:"bytes,3,rep,name=outgoing_txs,json=outgoingTxs,proto3" json:"outgoing_txs,omitempty"`
+	Confirmations                    []*types.Any                `protobuf:"bytes,4,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
+	EthereumEventVoteRecords         []*EthereumEventVoteRecord  `protobuf:"bytes,9,rep,name=ethereum_event_vote_records,json=ethereumEventVoteRecords,proto3" json:"ethereum_event_vote_records,omitempty"`
+	DelegateKeys                     []*MsgDelegateKeys          `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
+	Erc20ToDenoms                    []*ERC20ToDenom             `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
+	UnbatchedSendToEthereumTxs       []*SendToEthereum           `protobuf:"bytes,12,rep,name=unbatched_send_to_ethereum_txs,json=unbatchedSendToEthereumTxs,proto3" json:"unbatched_send_to_ethereum_txs,omitempty"`
+	LastOutgoingBatchNonce           uint64                      `protobuf:"varint,13,opt,name=last_outgoing_batch_nonce,json=lastOutgoingBatchNonce,proto3" json:"last_outgoing_batch_nonce,omitempty"`
+	LastSendToEthereumId             uint64                      `protobuf:"varint,14,opt,name=last_send_to_ethereum_id,json=lastSendToEthereumId,proto3" json:"last_send_to_ethereum_id,omitempty"`
+	LatestSignerSetTxNonce           uint64                      `protobuf:"varint,15,opt,name=latest_signer_set_tx_nonce,json=latestSignerSetTxNonce,proto3" json:"latest_signer_set_tx_nonce,omitempty"`
+	LastObservedSignerSet            *SignerSetTx                `protobuf:"bytes,16,opt,name=last_observed_signer_set,json=lastObservedSignerSet,proto3" json:"last_observed_signer_set,omitempty"`
+	LastEthereumBlockHeight          LatestEthereumBlockHeight   `protobuf:"bytes,17,opt,name=last_ethereum_block_height,json=lastEthereumBlockHeight,proto3" json:"last_ethereum_block_height"`
+	LastSlashedOutgoingTxBlock       uint64                      `protobuf:"varint,18,opt,name=last_slashed_outgoing_tx_block,json=lastSlashedOutgoingTxBlock,proto3" json:"last_slashed_outgoing_tx_block,omitempty"`
+	LastSlashedContractCallTxBlock   uint64                      `protobuf:"varint,19,opt,name=last_slashed_contract_call_tx_block,json=lastSlashedContractCallTxBlock,proto3" json:"last_slashed_contract_call_tx_block,omitempty"`
+	LastSlashedEventNonce            uint64                      `protobuf:"varint,20,opt,name=last_slashed_event_nonce,json=lastSlashedEventNonce,proto3" json:"last_slashed_event_nonce,omitempty"`
+	LastUnbondingBlockHeight         uint64                      `protobuf:"varint,21,opt,name=last_unbonding_block_height,json=lastUnbondingBlockHeight,proto3" json:"last_unbonding_block_height,omitempty"`
+	LastEventNoncesByValidator       []LastEventNonceByValidator `protobuf:"bytes,22,rep,name=last_event_nonces_by_validator,json=lastEventNoncesByValidator,proto3" json:"last_event_nonces_by_validator"`
+	PastEthereumSignatureCheckpoints [][]byte                    `protobuf:"bytes,23,rep,name=past_ethereum_signature_checkpoints,json=pastEthereumSignatureCheckpoints,proto3" json:"past_ethereum_signature_checkpoints,omitempty"`
+	SigningInfos                     []GravitySigningInfo        `protobuf:"bytes,24,rep,name=signing_infos,json=signingInfos,proto3" json:"signing_infos"`
+	MissedSignatures                 []ValidatorMissedSignatures `protobuf:"bytes,25,rep,name=missed_signatures,json=missedSignatures,proto3" json:"missed_signatures"`
+	// bridge_halted_signer_set_nonce is only set if bridge_halted is true
+	BridgeHalted                   bool                            `protobuf:"varint,26,opt,name=bridge_halted,json=bridgeHalted,proto3" json:"bridge_halted,omitempty"`
+	BridgeHaltedSignerSetNonce     uint64                          `protobuf:"varint,27,opt,name=bridge_halted_signer_set_nonce,json=bridgeHaltedSignerSetNonce,proto3" json:"bridge_halted_signer_set_nonce,omitempty"`
+	BridgePaused                   bool                            `protobuf:"varint,28,opt,name=bridge_paused,json=bridgePaused,proto3" json:"bridge_paused,omitempty"`
+	PausedTokenContracts           []string                        `protobuf:"bytes,29,rep,name=paused_token_contracts,json=pausedTokenContracts,proto3" json:"paused_token_contracts,omitempty"`
+	ParkedSendToCosmosEvents       []SendToCosmosEvent             `protobuf:"bytes,30,rep,name=parked_send_to_cosmos_events,json=parkedSendToCosmosEvents,proto3" json:"parked_send_to_cosmos_events"`
+	OutflowBuckets                 []OutflowBucket                 `protobuf:"bytes,31,rep,name=outflow_buckets,json=outflowBuckets,proto3" json:"outflow_buckets"`
+	PendingSendToEthereums         []PendingSendToEthereum         `protobuf:"bytes,32,rep,name=pending_send_to_ethereums,json=pendingSendToEthereums,proto3" json:"pending_send_to_ethereums"`
+	EthereumEventSummaries         []EthereumEventSummary          `protobuf:"bytes,33,rep,name=ethereum_event_summaries,json=ethereumEventSummaries,proto3" json:"ethereum_event_summaries"`
+	Erc20Escrows                   []ERC20Token                    `protobuf:"bytes,34,rep,name=erc20_escrows,json=erc20Escrows,proto3" json:"erc20_escrows"`
+	BadSignatureEvidenceActivation *BadSignatureEvidenceActivation `protobuf:"bytes,35,opt,name=bad_signature_evidence_activation,json=badSignatureEvidenceActivation,proto3" json:"bad_signature_evidence_activation,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -318,25 +504,186 @@ func (m *GenesisState) GetUnbatchedSendToEthereumTxs() []*SendToEthereum {
 	return nil
 }
 
-// This records the relationship between an ERC20 token and the denom
-// of the corresponding Cosmos originated asset
-type ERC20ToDenom struct {
-	Erc20 string `protobuf:"bytes,1,opt,name=erc20,proto3" json:"erc20,omitempty"`
-	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+func (m *GenesisState) GetLastOutgoingBatchNonce() uint64 {
+	if m != nil {
+		return m.LastOutgoingBatchNonce
+	}
+	return 0
 }
 
-func (m *ERC20ToDenom) Reset()         { *m = ERC20ToDenom{} }
-func (m *ERC20ToDenom) String() string { return proto.CompactTextString(m) }
-func (*ERC20ToDenom) ProtoMessage()    {}
-func (*ERC20ToDenom) Descriptor() ([]byte, []int) {
+func (m *GenesisState) GetLastSendToEthereumId() uint64 {
+	if m != nil {
+		return m.LastSendToEthereumId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLatestSignerSetTxNonce() uint64 {
+	if m != nil {
+		return m.LatestSignerSetTxNonce
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastObservedSignerSet() *SignerSetTx {
+	if m != nil {
+		return m.LastObservedSignerSet
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastEthereumBlockHeight() LatestEthereumBlockHeight {
+	if m != nil {
+		return m.LastEthereumBlockHeight
+	}
+	return LatestEthereumBlockHeight{}
+}
+
+func (m *GenesisState) GetLastSlashedOutgoingTxBlock() uint64 {
+	if m != nil {
+		return m.LastSlashedOutgoingTxBlock
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastSlashedContractCallTxBlock() uint64 {
+	if m != nil {
+		return m.LastSlashedContractCallTxBlock
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastSlashedEventNonce() uint64 {
+	if m != nil {
+		return m.LastSlashedEventNonce
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastUnbondingBlockHeight() uint64 {
+	if m != nil {
+		return m.LastUnbondingBlockHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastEventNoncesByValidator() []LastEventNonceByValidator {
+	if m != nil {
+		return m.LastEventNoncesByValidator
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPastEthereumSignatureCheckpoints() [][]byte {
+	if m != nil {
+		return m.PastEthereumSignatureCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSigningInfos() []GravitySigningInfo {
+	if m != nil {
+		return m.SigningInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMissedSignatures() []ValidatorMissedSignatures {
+	if m != nil {
+		return m.MissedSignatures
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgeHalted() bool {
+	if m != nil {
+		return m.BridgeHalted
+	}
+	return false
+}
+
+func (m *GenesisState) GetBridgeHaltedSignerSetNonce() uint64 {
+	if m != nil {
+		return m.BridgeHaltedSignerSetNonce
+	}
+	return 0
+}
+
+func (m *GenesisState) GetBridgePaused() bool {
+	if m != nil {
+		return m.BridgePaused
+	}
+	return false
+}
+
+func (m *GenesisState) GetPausedTokenContracts() []string {
+	if m != nil {
+		return m.PausedTokenContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParkedSendToCosmosEvents() []SendToCosmosEvent {
+	if m != nil {
+		return m.ParkedSendToCosmosEvents
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOutflowBuckets() []OutflowBucket {
+	if m != nil {
+		return m.OutflowBuckets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingSendToEthereums() []PendingSendToEthereum {
+	if m != nil {
+		return m.PendingSendToEthereums
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEthereumEventSummaries() []EthereumEventSummary {
+	if m != nil {
+		return m.EthereumEventSummaries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetErc20Escrows() []ERC20Token {
+	if m != nil {
+		return m.Erc20Escrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBadSignatureEvidenceActivation() *BadSignatureEvidenceActivation {
+	if m != nil {
+		return m.BadSignatureEvidenceActivation
+	}
+	return nil
+}
+
+// LastEventNonceByValidator records the nonce of the last Ethereum event a
+// validator voted on
+type LastEventNonceByValidator struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	EventNonce       uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+}
+
+func (m *LastEventNonceByValidator) Reset()         { *m = LastEventNonceByValidator{} }
+func (m *LastEventNonceByValidator) String() string { return proto.CompactTextString(m) }
+func (*LastEventNonceByValidator) ProtoMessage()    {}
+func (*LastEventNonceByValidator) Descriptor() ([]byte, []int) {
 	return fileDescriptor_387b0aba880adb60, []int{2}
 }
-func (m *ERC20ToDenom) XXX_Unmarshal(b []byte) error {
+func (m *LastEventNonceByValidator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ERC20ToDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LastEventNonceByValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ERC20ToDenom.Marshal(b, m, deterministic)
+		return xxx_messageInfo_LastEventNonceByValidator.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -346,114 +693,350 @@ func (m *ERC20ToDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ERC20ToDenom) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ERC20ToDenom.Merge(m, src)
+func (m *LastEventNonceByValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastEventNonceByValidator.Merge(m, src)
 }
-func (m *ERC20ToDenom) XXX_Size() int {
+func (m *LastEventNonceByValidator) XXX_Size() int {
 	return m.Size()
 }
-func (m *ERC20ToDenom) XXX_DiscardUnknown() {
-	xxx_messageInfo_ERC20ToDenom.DiscardUnknown(m)
+func (m *LastEventNonceByValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastEventNonceByValidator.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ERC20ToDenom proto.InternalMessageInfo
+var xxx_messageInfo_LastEventNonceByValidator proto.InternalMessageInfo
 
-func (m *ERC20ToDenom) GetErc20() string {
+func (m *LastEventNonceByValidator) GetValidatorAddress() string {
 	if m != nil {
-		return m.Erc20
+		return m.ValidatorAddress
 	}
 	return ""
 }
 
-func (m *ERC20ToDenom) GetDenom() string {
+func (m *LastEventNonceByValidator) GetEventNonce() uint64 {
 	if m != nil {
-		return m.Denom
+		return m.EventNonce
 	}
-	return ""
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
-	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
-	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+// ValidatorMissedSignatures records the indexes of the missed entries in the
+// sliding window of a validator
+type ValidatorMissedSignatures struct {
+	ValidatorAddress string   `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	MissedIndexes    []uint64 `protobuf:"varint,2,rep,packed,name=missed_indexes,json=missedIndexes,proto3" json:"missed_indexes,omitempty"`
 }
 
-func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
+func (m *ValidatorMissedSignatures) Reset()         { *m = ValidatorMissedSignatures{} }
+func (m *ValidatorMissedSignatures) String() string { return proto.CompactTextString(m) }
+func (*ValidatorMissedSignatures) ProtoMessage()    {}
+func (*ValidatorMissedSignatures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{3}
+}
+func (m *ValidatorMissedSignatures) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorMissedSignatures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorMissedSignatures.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorMissedSignatures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorMissedSignatures.Merge(m, src)
+}
+func (m *ValidatorMissedSignatures) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorMissedSignatures) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorMissedSignatures.DiscardUnknown(m)
+}
 
-var fileDescriptor_387b0aba880adb60 = []byte{
-	// 907 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xb6, 0xa9, 0x1b, 0x9a, 0xb1, 0x4d, 0xca, 0xe0, 0xc0, 0xd6, 0x29, 0xae, 0x09, 0xa2, 0x0a,
-	0x88, 0xac, 0x13, 0x23, 0xf1, 0x13, 0x01, 0x6a, 0xf3, 0x03, 0x54, 0x08, 0x8a, 0xd6, 0x16, 0x48,
-	0x5c, 0x30, 0x8c, 0x77, 0x4e, 0xc6, 0x4b, 0xec, 0x99, 0x68, 0x67, 0xd6, 0xb5, 0xef, 0x78, 0x84,
-	0x3e, 0x0b, 0x8f, 0xc0, 0x55, 0x2f, 0x7b, 0x89, 0x10, 0xaa, 0x50, 0xf2, 0x22, 0x68, 0x7e, 0xd6,
-	0x5e, 0x3b, 0xe5, 0x26, 0x57, 0xeb, 0x39, 0xdf, 0xf7, 0x9d, 0xf3, 0xcd, 0x1c, 0xcf, 0x19, 0x14,
-	0xf0, 0x94, 0x4e, 0x12, 0x3d, 0xeb, 0x4c, 0xf6, 0x3b, 0x1c, 0x04, 0xa8, 0x44, 0x85, 0xe7, 0xa9,
-	0xd4, 0x12, 0x23, 0x8f, 0x84, 0x93, 0xfd, 0x66, 0x83, 0x4b, 0x2e, 0x6d, 0xb8, 0x63, 0x7e, 0x39,
-	0x46, 0x73, 0x49, 0xeb, 0xc9, 0x0e, 0xd9, 0x2c, 0x20, 0x63, 0xc5, 0x7d, 0xca, 0xe6, 0x1d, 0x2e,
-	0x25, 0x1f, 0x41, 0xc7, 0xae, 0x06, 0xd9, 0x69, 0x87, 0x0a, 0xaf, 0xd8, 0xfe, 0xf3, 0x16, 0x5a,
-	0xfb, 0x81, 0xa6, 0x74, 0xac, 0xf0, 0xdb, 0x28, 0x2f, 0x4d, 0x12, 0x16, 0x94, 0xdb, 0xe5, 0x9d,
-	0xf5, 0x68, 0xdd, 0x47, 0x1e, 0x31, 0xbc, 0x87, 0x1a, 0xb1, 0x14, 0x3a, 0xa5, 0xb1, 0x26, 0x4a,
-	0x66, 0x69, 0x0c, 0x64, 0x48, 0xd5, 0x30, 0x78, 0xc5, 0x12, 0x71, 0x8e, 0xf5, 0x2c, 0xf4, 0x0d,
-	0x55, 0x43, 0xfc, 0x31, 0x7a, 0x6b, 0x90, 0x26, 0x8c, 0x03, 0x01, 0x3d, 0x84, 0x14, 0xb2, 0x31,
-	0xa1, 0x8c, 0xa5, 0xa0, 0x54, 0x50, 0xb1, 0xa2, 0x4d, 0x07, 0x9f, 0x78, 0xf4, 0xa1, 0x03, 0xf1,
-	0x7d, 0xb4, 0xe1, 0x75, 0xf1, 0x90, 0x26, 0xc2, 0xb8, 0xb9, 0xd9, 0x2e, 0xef, 0x54, 0xa2, 0xba,
-	0x0b, 0x1f, 0x99, 0xe8, 0x23, 0x86, 0xbf, 0x44, 0x77, 0x55, 0xc2, 0x05, 0x30, 0x62, 0x3f, 0x29,
-	0x51, 0xa0, 0x89, 0x9e, 0x2a, 0xf2, 0x24, 0x11, 0x4c, 0x3e, 0x09, 0xd6, 0xac, 0x28, 0x70, 0x9c,
-	0x9e, 0xa5, 0xf4, 0x40, 0xf7, 0xa7, 0xea, 0x27, 0x8b, 0xe3, 0x2e, 0xda, 0xf4, 0xfa, 0x01, 0xd5,
-	0xf1, 0x10, 0xe6, 0xc2, 0x57, 0xad, 0xf0, 0x0d, 0x07, 0x1e, 0x3a, 0xcc, 0x6b, 0x3e, 0x47, 0xcd,
-	0xf9, 0x66, 0x0c, 0x4e, 0x75, 0x96, 0x2e, 0x84, 0xb7, 0x5c, 0xc5, 0x9c, 0xd1, 0x9b, 0x13, 0xbc,
-	0x7a, 0x1f, 0x6d, 0x6a, 0x9a, 0x72, 0xd0, 0xe6, 0x44, 0x88, 0x9e, 0x12, 0x9d, 0x8c, 0x41, 0x66,
-	0x3a, 0x40, 0x56, 0x88, 0x1d, 0x78, 0xa2, 0x87, 0xfd, 0x69, 0xdf, 0x21, 0xf8, 0x43, 0x84, 0xe9,
-	0x04, 0x52, 0xca, 0x81, 0x0c, 0x46, 0x32, 0x3e, 0xb3, 0x92, 0xa0, 0x6a, 0xf9, 0xb7, 0x3d, 0x72,
-	0x68, 0x00, 0x23, 0xc0, 0x5f, 0xa0, 0xad, 0x9c, 0x3d, 0xb7, 0x59, 0x90, 0xd5, 0x9c, 0x3f, 0x4f,
-	0xc9, 0xcf, 0x7d, 0x21, 0x17, 0xe8, 0xae, 0x1a, 0x51, 0x35, 0x24, 0xa7, 0xa6, 0x95, 0x89, 0x14,
-	0xcb, 0x27, 0x1b, 0xd4, 0xdb, 0xe5, 0x9d, 0xda, 0x61, 0xf8, 0xec, 0xc5, 0xbd, 0xd2, 0xdf, 0x2f,
-	0xee, 0xdd, 0xe7, 0x89, 0x1e, 0x66, 0x83, 0x30, 0x96, 0xe3, 0x4e, 0x2c, 0xd5, 0x58, 0x2a, 0xff,
-	0xd9, 0x55, 0xec, 0xac, 0xa3, 0x67, 0xe7, 0xa0, 0xc2, 0x63, 0x88, 0xa3, 0xc0, 0xe6, 0xfc, 0xca,
-	0xa7, 0x2c, 0x34, 0x02, 0xff, 0x8a, 0x1a, 0x2b, 0xf5, 0x6c, 0x27, 0x82, 0xd7, 0xae, 0x55, 0x07,
-	0x2f, 0xd5, 0xb1, 0x7d, 0xc3, 0x33, 0xf4, 0xce, 0x4a, 0x85, 0xab, 0xed, 0x0b, 0x36, 0xae, 0x55,
-	0xae, 0xb5, 0x54, 0xee, 0x64, 0xb5, 0xe7, 0xf8, 0x69, 0x19, 0xed, 0xae, 0xd4, 0x8e, 0xa5, 0x38,
-	0x1d, 0x25, 0xb1, 0x4e, 0x04, 0x7f, 0x99, 0x8f, 0xdb, 0xd7, 0xf2, 0xf1, 0xfe, 0x92, 0x8f, 0xa3,
-	0x45, 0x89, 0xab, 0x96, 0x1e, 0xa3, 0xf7, 0x32, 0x31, 0x90, 0x82, 0x11, 0xab, 0x31, 0x36, 0x5e,
-	0x7e, 0x75, 0x5e, 0xb7, 0x7f, 0x94, 0xb6, 0x23, 0xf7, 0x3c, 0xf7, 0xea, 0x15, 0x3a, 0xa8, 0xfc,
-	0xfe, 0x4f, 0xbb, 0xb4, 0xfd, 0x47, 0x05, 0xd5, 0xbe, 0x76, 0x43, 0xac, 0xa7, 0xa9, 0x06, 0xfc,
-	0x01, 0x5a, 0x3b, 0xb7, 0x43, 0xc5, 0x8e, 0x91, 0x6a, 0x17, 0x87, 0x8b, 0xa1, 0x16, 0xba, 0x71,
-	0x13, 0x79, 0x06, 0xfe, 0x0c, 0xdd, 0x19, 0x51, 0xa5, 0x89, 0x1c, 0x28, 0x48, 0x27, 0xc0, 0x08,
-	0x4c, 0x40, 0x68, 0x22, 0xa4, 0x88, 0xc1, 0x0e, 0x97, 0x4a, 0xf4, 0xa6, 0x21, 0x3c, 0xf6, 0xf8,
-	0x89, 0x81, 0xbf, 0x37, 0x28, 0xfe, 0x04, 0xd5, 0x64, 0xa6, 0xb9, 0x34, 0xfb, 0xd0, 0x53, 0x15,
-	0xdc, 0x68, 0xdf, 0xd8, 0xa9, 0x76, 0x1b, 0xa1, 0x1b, 0x77, 0x61, 0x3e, 0xee, 0xc2, 0x87, 0x62,
-	0x16, 0x55, 0x73, 0x66, 0x7f, 0xaa, 0xf0, 0x01, 0xaa, 0x9b, 0x56, 0x24, 0xe9, 0x98, 0x9a, 0x33,
-	0x33, 0xf3, 0xe8, 0xff, 0x95, 0xcb, 0x54, 0x3c, 0x40, 0x5b, 0xf3, 0xd6, 0x39, 0xab, 0x13, 0xa9,
-	0x81, 0xa4, 0x10, 0xcb, 0x94, 0xa9, 0x60, 0xdd, 0x66, 0x7a, 0xb7, 0xb8, 0xe1, 0xbc, 0x0f, 0xd6,
-	0xf9, 0x8f, 0x52, 0x43, 0x64, 0xb9, 0x8b, 0x39, 0xb1, 0x02, 0x28, 0xfc, 0x00, 0xd5, 0x19, 0x8c,
-	0x80, 0x53, 0x0d, 0xe4, 0x0c, 0x66, 0x2a, 0x40, 0x36, 0xeb, 0x56, 0x31, 0xeb, 0x77, 0x8a, 0x1f,
-	0x7b, 0xce, 0xb7, 0x30, 0x53, 0x51, 0x8d, 0x15, 0x56, 0xf8, 0x01, 0xda, 0x80, 0x34, 0xee, 0xee,
-	0x11, 0x2d, 0x09, 0x03, 0x21, 0xc7, 0x2a, 0xa8, 0xda, 0x1c, 0xc1, 0x92, 0xb3, 0xe8, 0xa8, 0xbb,
-	0xd7, 0x97, 0xc7, 0x86, 0x10, 0xd5, 0xad, 0xc0, 0xaf, 0x14, 0xfe, 0x05, 0xb5, 0x32, 0xe1, 0x06,
-	0x23, 0x23, 0x0a, 0x04, 0x33, 0xa9, 0xe6, 0x3b, 0x37, 0xc7, 0x5d, 0xb3, 0x09, 0x9b, 0xc5, 0x84,
-	0x3d, 0x10, 0xac, 0x2f, 0xf3, 0x0d, 0x47, 0xcd, 0x79, 0x86, 0x65, 0xa0, 0x3f, 0x55, 0xdb, 0x07,
-	0xa8, 0x56, 0x2c, 0x8f, 0x1b, 0xe8, 0xa6, 0x35, 0xe0, 0x5f, 0x1e, 0xb7, 0x30, 0x51, 0x6b, 0xdf,
-	0x3f, 0x33, 0x6e, 0x71, 0x18, 0x3d, 0xbb, 0x68, 0x95, 0x9f, 0x5f, 0xb4, 0xca, 0xff, 0x5e, 0xb4,
-	0xca, 0x4f, 0x2f, 0x5b, 0xa5, 0xe7, 0x97, 0xad, 0xd2, 0x5f, 0x97, 0xad, 0xd2, 0xcf, 0x9f, 0x16,
-	0x2e, 0xcd, 0x39, 0x70, 0x3e, 0xfb, 0x6d, 0x92, 0xbf, 0x91, 0xbb, 0xee, 0xf5, 0xe8, 0x8c, 0x25,
-	0xcb, 0x46, 0xd0, 0x99, 0xe6, 0x71, 0x77, 0x95, 0x06, 0x6b, 0xb6, 0xe9, 0x1f, 0xfd, 0x17, 0x00,
-	0x00, 0xff, 0xff, 0x37, 0xc4, 0xc6, 0x03, 0x9a, 0x07, 0x00, 0x00,
+var xxx_messageInfo_ValidatorMissedSignatures proto.InternalMessageInfo
+
+func (m *ValidatorMissedSignatures) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorMissedSignatures) GetMissedIndexes() []uint64 {
+	if m != nil {
+		return m.MissedIndexes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// OutflowBucket is the amount of a denom sent to Ethereum in the bucket of
+// blocks starting at start_height
+type OutflowBucket struct {
+	Denom       string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	StartHeight uint64                                 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *OutflowBucket) Reset()         { *m = OutflowBucket{} }
+func (m *OutflowBucket) String() string { return proto.CompactTextString(m) }
+func (*OutflowBucket) ProtoMessage()    {}
+func (*OutflowBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{4}
+}
+func (m *OutflowBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutflowBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutflowBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutflowBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutflowBucket.Merge(m, src)
+}
+func (m *OutflowBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutflowBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutflowBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutflowBucket proto.InternalMessageInfo
+
+func (m *OutflowBucket) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *OutflowBucket) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+// This records the relationship between an ERC20 token and the denom
+// of the corresponding Cosmos originated asset
+type ERC20ToDenom struct {
+	Erc20 string `protobuf:"bytes,1,opt,name=erc20,proto3" json:"erc20,omitempty"`
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *ERC20ToDenom) Reset()         { *m = ERC20ToDenom{} }
+func (m *ERC20ToDenom) String() string { return proto.CompactTextString(m) }
+func (*ERC20ToDenom) ProtoMessage()    {}
+func (*ERC20ToDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{5}
+}
+func (m *ERC20ToDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20ToDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20ToDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC20ToDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20ToDenom.Merge(m, src)
+}
+func (m *ERC20ToDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20ToDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20ToDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20ToDenom proto.InternalMessageInfo
+
+func (m *ERC20ToDenom) GetErc20() string {
+	if m != nil {
+		return m.Erc20
+	}
+	return ""
+}
+
+func (m *ERC20ToDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
+	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
+	proto.RegisterType((*LastEventNonceByValidator)(nil), "gravity.v1.LastEventNonceByValidator")
+	proto.RegisterType((*ValidatorMissedSignatures)(nil), "gravity.v1.ValidatorMissedSignatures")
+	proto.RegisterType((*OutflowBucket)(nil), "gravity.v1.OutflowBucket")
+	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+}
+
+func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
+
+var fileDescriptor_387b0aba880adb60 = []byte{
+	// 2023 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x72, 0x1b, 0xb7,
+	0x15, 0x16, 0x2d, 0x45, 0x8d, 0x41, 0x4a, 0xb2, 0xa0, 0x1f, 0x43, 0x3f, 0xa6, 0x28, 0xc9, 0xce,
+	0xa8, 0x69, 0x4c, 0xda, 0x6a, 0x27, 0x9e, 0x7a, 0x9a, 0x4e, 0x4c, 0x49, 0xb6, 0x35, 0x89, 0x2b,
+	0x75, 0xc9, 0x26, 0x99, 0x5e, 0x74, 0x03, 0xee, 0x42, 0xcb, 0xad, 0x76, 0x17, 0x9c, 0x05, 0x48,
+	0x91, 0xb9, 0xca, 0x23, 0xf8, 0x01, 0xfa, 0x40, 0xb9, 0xcc, 0x65, 0xa7, 0xd3, 0xc9, 0x74, 0xec,
+	0xf7, 0xe8, 0x74, 0x70, 0x80, 0x5d, 0x62, 0x49, 0xa9, 0x33, 0xd6, 0x95, 0x44, 0x7c, 0xdf, 0xf9,
+	0x01, 0x70, 0x70, 0xce, 0x47, 0x22, 0x12, 0xa4, 0x74, 0x10, 0xca, 0x51, 0x63, 0xf0, 0xb4, 0x11,
+	0xb0, 0x84, 0x89, 0x50, 0xd4, 0x7b, 0x29, 0x97, 0x1c, 0x23, 0x83, 0xd4, 0x07, 0x4f, 0x37, 0x57,
+	0x03, 0x1e, 0x70, 0x58, 0x6e, 0xa8, 0xff, 0x34, 0x63, 0xb3, 0x60, 0x6b, 0xc8, 0x1a, 0x59, 0xb3,
+	0x90, 0x58, 0x04, 0xc6, 0xe5, 0xe6, 0x46, 0xc0, 0x79, 0x10, 0xb1, 0x06, 0x7c, 0xea, 0xf4, 0x2f,
+	0x1a, 0x34, 0x31, 0x16, 0x7b, 0xff, 0x58, 0x41, 0xf3, 0xe7, 0x34, 0xa5, 0xb1, 0xc0, 0x0f, 0x50,
+	0x16, 0xda, 0x0d, 0x7d, 0x52, 0xaa, 0x95, 0x0e, 0xee, 0x3a, 0x77, 0xcd, 0xca, 0xa9, 0x8f, 0x9f,
+	0xa0, 0x55, 0x8f, 0x27, 0x32, 0xa5, 0x9e, 0x74, 0x05, 0xef, 0xa7, 0x1e, 0x73, 0xbb, 0x54, 0x74,
+	0xc9, 0x1d, 0x20, 0xe2, 0x0c, 0x6b, 0x01, 0xf4, 0x9a, 0x8a, 0x2e, 0xfe, 0x1c, 0xdd, 0xef, 0xa4,
+	0xa1, 0x1f, 0x30, 0x97, 0xc9, 0x2e, 0x4b, 0x59, 0x3f, 0x76, 0xa9, 0xef, 0xa7, 0x4c, 0x08, 0x32,
+	0x07, 0x46, 0x6b, 0x1a, 0x3e, 0x31, 0xe8, 0x0b, 0x0d, 0xe2, 0x4f, 0xd0, 0x92, 0xb1, 0xf3, 0xba,
+	0x34, 0x4c, 0x54, 0x36, 0x1f, 0xd5, 0x4a, 0x07, 0x73, 0xce, 0x82, 0x5e, 0x3e, 0x52, 0xab, 0xa7,
+	0x3e, 0xfe, 0x23, 0xda, 0x16, 0x61, 0x90, 0x30, 0xdf, 0x85, 0x3f, 0xa9, 0x2b, 0x98, 0x74, 0xe5,
+	0x50, 0xb8, 0x57, 0x61, 0xe2, 0xf3, 0x2b, 0x32, 0x0f, 0x46, 0x44, 0x73, 0x5a, 0x40, 0x69, 0x31,
+	0xd9, 0x1e, 0x8a, 0x6f, 0x01, 0xc7, 0x87, 0x68, 0xcd, 0xd8, 0x77, 0xa8, 0xf4, 0xba, 0x2c, 0x37,
+	0xfc, 0x15, 0x18, 0xae, 0x68, 0xb0, 0xa9, 0x31, 0x63, 0xf3, 0x07, 0xb4, 0x99, 0x6f, 0x46, 0xe1,
+	0x54, 0xf6, 0xd3, 0xb1, 0xe1, 0xc7, 0x3a, 0x62, 0xc6, 0x68, 0xe5, 0x04, 0x63, 0xfd, 0x14, 0xad,
+	0x49, 0x9a, 0x06, 0x4c, 0xaa, 0x13, 0x71, 0xe5, 0xd0, 0x95, 0x61, 0xcc, 0x78, 0x5f, 0x12, 0x04,
+	0x86, 0x58, 0x83, 0x27, 0xb2, 0xdb, 0x1e, 0xb6, 0x35, 0x82, 0x3f, 0x43, 0x98, 0x0e, 0x58, 0x4a,
+	0x03, 0xe6, 0x76, 0x22, 0xee, 0x5d, 0x82, 0x09, 0x29, 0x03, 0xff, 0x9e, 0x41, 0x9a, 0x0a, 0x50,
+	0x06, 0xf8, 0x0b, 0xb4, 0x95, 0xb1, 0xf3, 0x34, 0x2d, 0xb3, 0x8a, 0xce, 0xcf, 0x50, 0xb2, 0x73,
+	0x1f, 0x9b, 0x27, 0x68, 0x5b, 0x44, 0x54, 0x74, 0xdd, 0x0b, 0x75, 0x95, 0x21, 0x4f, 0x8a, 0x27,
+	0x4b, 0x16, 0x6a, 0xa5, 0x83, 0x4a, 0xb3, 0xfe, 0xd3, 0x2f, 0x3b, 0x33, 0xff, 0xfa, 0x65, 0xe7,
+	0x93, 0x20, 0x94, 0xdd, 0x7e, 0xa7, 0xee, 0xf1, 0xb8, 0xe1, 0x71, 0x11, 0x73, 0x61, 0xfe, 0x3c,
+	0x16, 0xfe, 0x65, 0x43, 0x8e, 0x7a, 0x4c, 0xd4, 0x8f, 0x99, 0xe7, 0x10, 0xf0, 0xf9, 0xd2, 0xb8,
+	0xb4, 0x2e, 0x02, 0x7f, 0x8f, 0x56, 0x27, 0xe2, 0xc1, 0x4d, 0x90, 0xc5, 0x5b, 0xc5, 0xc1, 0x85,
+	0x38, 0x70, 0x6f, 0x78, 0x84, 0x76, 0x27, 0x22, 0x4c, 0x5f, 0x1f, 0x59, 0xba, 0x55, 0xb8, 0x6a,
+	0x21, 0xdc, 0xc9, 0xe4, 0x9d, 0xe3, 0xb7, 0x25, 0xf4, 0x78, 0x22, 0xb6, 0xc7, 0x93, 0x8b, 0x28,
+	0xf4, 0x64, 0x98, 0x04, 0xd7, 0xe5, 0x71, 0xef, 0x56, 0x79, 0xfc, 0xba, 0x90, 0xc7, 0xd1, 0x38,
+	0xc4, 0x74, 0x4a, 0x67, 0xe8, 0x51, 0x3f, 0xe9, 0xf0, 0xc4, 0x77, 0xc1, 0x46, 0xa5, 0x71, 0xfd,
+	0xd3, 0x59, 0x86, 0x42, 0xa9, 0x69, 0x72, 0xcb, 0x70, 0xaf, 0x79, 0x42, 0x3f, 0x96, 0xd0, 0xa3,
+	0xa9, 0x1b, 0xf4, 0xaf, 0xdb, 0x1b, 0xbe, 0xd5, 0xde, 0x76, 0x27, 0xae, 0xd4, 0x9f, 0xde, 0xd3,
+	0x31, 0xda, 0x31, 0xaf, 0x38, 0x6f, 0x4f, 0x1e, 0x8d, 0x22, 0x7b, 0x37, 0x2b, 0xb0, 0x9b, 0x2d,
+	0x4d, 0x3b, 0x32, 0xac, 0x23, 0x1a, 0x45, 0xe3, 0x8d, 0x48, 0xb4, 0x33, 0x7d, 0x57, 0x05, 0x6f,
+	0x64, 0xf5, 0x56, 0x3b, 0xd8, 0x9a, 0xbc, 0x1d, 0x2b, 0x38, 0xae, 0x23, 0x68, 0x32, 0xea, 0x1e,
+	0xc2, 0xe4, 0x82, 0x67, 0xf9, 0xae, 0x41, 0xbe, 0xcb, 0x06, 0x3a, 0x4d, 0x2e, 0xb8, 0xc9, 0x92,
+	0xa2, 0xb5, 0x38, 0x34, 0x8f, 0xd2, 0x77, 0x7b, 0x2c, 0xcd, 0x2c, 0xd6, 0x6f, 0xf7, 0x60, 0xe2,
+	0x50, 0x3f, 0x47, 0xff, 0x9c, 0xa5, 0x26, 0x84, 0x83, 0x56, 0x78, 0x5f, 0x5e, 0x44, 0xfc, 0xca,
+	0x4d, 0xa9, 0x64, 0x6e, 0x14, 0xc6, 0xa1, 0x14, 0xe4, 0x7e, 0x6d, 0xf6, 0xa0, 0x7c, 0xb8, 0x5d,
+	0x1f, 0x0f, 0xa7, 0xfa, 0x99, 0xa6, 0x39, 0x54, 0xb2, 0xaf, 0x15, 0xa9, 0x39, 0xa7, 0xc2, 0x3b,
+	0xcb, 0x7c, 0x62, 0x5d, 0xe0, 0xbf, 0xa3, 0xad, 0x48, 0x75, 0x36, 0xf7, 0x2a, 0x94, 0x5d, 0x3f,
+	0xa5, 0x57, 0x34, 0x72, 0x65, 0x37, 0x65, 0xa2, 0xcb, 0x23, 0x5f, 0x10, 0x02, 0xbe, 0x1f, 0xda,
+	0xbe, 0xbf, 0x56, 0xf4, 0x6f, 0x73, 0x76, 0x3b, 0x23, 0x9b, 0x18, 0x1b, 0xd1, 0x0d, 0xb8, 0xc0,
+	0xbf, 0x43, 0xeb, 0x53, 0xb1, 0x7c, 0x16, 0xd1, 0x11, 0xd9, 0x80, 0x53, 0x5d, 0x9d, 0x30, 0x3d,
+	0x56, 0x18, 0x6e, 0xa0, 0x15, 0x8b, 0x1f, 0xf4, 0x69, 0xea, 0x87, 0x34, 0x21, 0x9b, 0x7a, 0xb6,
+	0x8d, 0xa1, 0x57, 0x06, 0x51, 0x9d, 0x8b, 0x0d, 0x58, 0x22, 0xdd, 0x01, 0x97, 0x6c, 0xbc, 0x19,
+	0xb2, 0x75, 0xbb, 0x8b, 0x00, 0x5f, 0xdf, 0x70, 0xc9, 0xf2, 0x9d, 0xe0, 0xef, 0xd0, 0xda, 0x75,
+	0x11, 0x04, 0xd9, 0x86, 0xe3, 0xaa, 0xda, 0xc7, 0x75, 0x32, 0x65, 0x6e, 0x0e, 0x6a, 0x65, 0xda,
+	0xb1, 0x80, 0xb9, 0xac, 0x9a, 0xa3, 0xeb, 0xa5, 0x8c, 0x42, 0xad, 0x87, 0x89, 0x64, 0xe9, 0x80,
+	0x46, 0xe4, 0x01, 0x9c, 0xd1, 0x1a, 0xc0, 0x47, 0x06, 0x3d, 0x35, 0x20, 0x7e, 0x88, 0x16, 0x63,
+	0x3a, 0xd4, 0x2d, 0xda, 0x15, 0xe1, 0x0f, 0x8c, 0x54, 0x81, 0x5e, 0x89, 0xe9, 0x10, 0xba, 0x6d,
+	0x2b, 0xfc, 0x81, 0xe1, 0x23, 0xb4, 0xa8, 0x6a, 0x54, 0xb3, 0x2e, 0x18, 0x13, 0x64, 0x07, 0x12,
+	0xbe, 0x6f, 0x27, 0xfc, 0x26, 0xd4, 0xfd, 0xf9, 0x25, 0x63, 0x26, 0xd3, 0x4a, 0x3c, 0x5e, 0x82,
+	0x14, 0x55, 0x28, 0xde, 0x97, 0x42, 0xd2, 0xc4, 0x57, 0x0f, 0xc4, 0xcc, 0x68, 0x52, 0xd3, 0x29,
+	0xc6, 0x74, 0x78, 0x36, 0x46, 0xcd, 0x90, 0xc6, 0x7b, 0x68, 0x61, 0x9c, 0x62, 0x40, 0x05, 0xd9,
+	0x05, 0x76, 0x39, 0xcb, 0xf0, 0x15, 0x15, 0x6a, 0x1b, 0x1a, 0xef, 0x50, 0xc1, 0x80, 0xb4, 0xa7,
+	0xb7, 0x01, 0xab, 0x4d, 0x2a, 0x98, 0x62, 0x7d, 0x86, 0xb0, 0x66, 0xc9, 0x94, 0x26, 0xe2, 0x82,
+	0xa5, 0xc0, 0xdc, 0xd7, 0x73, 0x17, 0x90, 0xb6, 0x01, 0x14, 0xfb, 0x1c, 0x61, 0xc9, 0x2f, 0x59,
+	0x52, 0x64, 0x3f, 0x9c, 0x7e, 0x34, 0x6d, 0xc5, 0xb2, 0x2c, 0xcd, 0xee, 0xef, 0xc9, 0x89, 0xf5,
+	0xe7, 0x73, 0x3f, 0xfe, 0xbb, 0x36, 0xb3, 0xf7, 0xdf, 0x65, 0x54, 0x79, 0xa5, 0xe5, 0x61, 0x4b,
+	0x52, 0xc9, 0xf0, 0xa7, 0x68, 0xbe, 0x07, 0x72, 0x0d, 0x04, 0x5a, 0xf9, 0x10, 0xdb, 0xce, 0xb5,
+	0x90, 0x73, 0x0c, 0x03, 0xff, 0x1e, 0x6d, 0x44, 0x54, 0x48, 0x97, 0x77, 0x04, 0x4b, 0x07, 0xcc,
+	0x77, 0x75, 0x3d, 0x25, 0x3c, 0xf1, 0x18, 0xc8, 0xb6, 0x39, 0x67, 0x5d, 0x11, 0xce, 0x0c, 0x0e,
+	0x55, 0xf4, 0x27, 0x85, 0xe2, 0x67, 0xa8, 0xc2, 0xfb, 0x32, 0xe0, 0xea, 0xe0, 0xe5, 0x50, 0x90,
+	0x59, 0xd8, 0xc9, 0x6a, 0x5d, 0x0b, 0xc9, 0x7a, 0x26, 0x24, 0xeb, 0x2f, 0x92, 0x91, 0x53, 0xce,
+	0x98, 0xed, 0xa1, 0xc0, 0xcf, 0xd1, 0x82, 0x1a, 0x72, 0x61, 0x1a, 0x43, 0xed, 0x28, 0xa5, 0x77,
+	0xb3, 0x65, 0x91, 0x8a, 0x3b, 0x68, 0x2b, 0x1f, 0x1c, 0x56, 0xe9, 0xa7, 0xcc, 0xe3, 0xa9, 0x2f,
+	0xc8, 0x5d, 0xf0, 0xb4, 0x5f, 0xa8, 0x7b, 0x43, 0xcf, 0xeb, 0xdf, 0x01, 0xee, 0x58, 0x81, 0x4d,
+	0x00, 0x02, 0x7f, 0x89, 0x16, 0x7c, 0x16, 0xb1, 0x40, 0xb5, 0xb6, 0x4b, 0x36, 0x12, 0x04, 0x81,
+	0xd7, 0xad, 0x42, 0x71, 0x8a, 0xe0, 0xd8, 0x70, 0xbe, 0x62, 0x23, 0xe1, 0x54, 0x7c, 0xeb, 0x13,
+	0xfe, 0x12, 0x2d, 0xb1, 0xd4, 0x3b, 0x7c, 0xe2, 0x4a, 0xee, 0xfa, 0x2c, 0xe1, 0xb1, 0x20, 0x65,
+	0xf0, 0x41, 0x0a, 0x99, 0x39, 0x47, 0x87, 0x4f, 0xda, 0xfc, 0x58, 0x11, 0x9c, 0x05, 0x30, 0x30,
+	0x9f, 0x04, 0xfe, 0x1b, 0xaa, 0xf6, 0x13, 0x5d, 0xce, 0xbe, 0x2b, 0x58, 0xe2, 0x2b, 0x57, 0xf9,
+	0xce, 0xd5, 0x71, 0x57, 0xc0, 0xe1, 0xa6, 0xed, 0xb0, 0xc5, 0x12, 0xbf, 0xcd, 0xb3, 0x0d, 0x3b,
+	0x9b, 0xb9, 0x87, 0x22, 0xd0, 0x1e, 0x5a, 0xf7, 0x9e, 0xdd, 0xa0, 0x2e, 0x64, 0x7d, 0xef, 0x0b,
+	0xd6, 0xbd, 0x1b, 0x1c, 0x5e, 0x86, 0xbe, 0xf7, 0xcf, 0x11, 0x01, 0xd3, 0xa9, 0xac, 0x42, 0x9f,
+	0x2c, 0x66, 0xfd, 0x53, 0xc8, 0x62, 0xcc, 0x53, 0x1f, 0x3f, 0x47, 0x9b, 0x11, 0x95, 0x4c, 0x59,
+	0xda, 0x7a, 0xc2, 0xc4, 0x5c, 0xca, 0x62, 0x2a, 0x86, 0xa5, 0x22, 0x74, 0xcc, 0x73, 0x44, 0x8a,
+	0x65, 0x3a, 0x76, 0x01, 0x8a, 0x68, 0xa2, 0x75, 0x58, 0xf6, 0xce, 0x9a, 0x5d, 0xbe, 0x39, 0x80,
+	0xbb, 0x2a, 0x1b, 0x21, 0x27, 0x25, 0x70, 0x97, 0x85, 0x41, 0x57, 0x82, 0xb6, 0x29, 0x1f, 0x3e,
+	0x2a, 0x8e, 0x1b, 0x95, 0x59, 0x41, 0x0f, 0xbf, 0x06, 0xb2, 0x79, 0x9e, 0xf7, 0x23, 0x7a, 0x2d,
+	0x8c, 0x9b, 0xa8, 0xaa, 0xcf, 0x4b, 0x0d, 0x79, 0xe6, 0xbb, 0xd6, 0xa3, 0xd1, 0x41, 0x41, 0xf7,
+	0xcc, 0x39, 0x90, 0x4f, 0x4b, 0x93, 0xce, 0xf2, 0xe7, 0x02, 0x9e, 0xf0, 0x57, 0x68, 0xbf, 0xe0,
+	0x63, 0x52, 0x78, 0x18, 0x47, 0x5a, 0xc4, 0x54, 0x2d, 0x47, 0x45, 0x31, 0xa1, 0x9d, 0x3d, 0xcb,
+	0x2e, 0xd0, 0x38, 0xb3, 0x9f, 0xfc, 0xaa, 0xee, 0x9c, 0x96, 0x07, 0xeb, 0xc5, 0x7f, 0xa1, 0x66,
+	0xb4, 0x90, 0xae, 0x96, 0x7c, 0x50, 0x35, 0xf6, 0xa1, 0x69, 0x49, 0x02, 0xbe, 0xff, 0x92, 0x31,
+	0xec, 0x83, 0xe0, 0xe6, 0x20, 0xac, 0x78, 0xc2, 0xed, 0x8c, 0xdc, 0x01, 0x8d, 0x42, 0x9f, 0x4a,
+	0x9e, 0x92, 0xf5, 0xda, 0xec, 0xf4, 0xb1, 0x0b, 0x39, 0x4e, 0xa1, 0x39, 0xfa, 0x26, 0x23, 0x9b,
+	0x63, 0xdf, 0x8c, 0x0a, 0x04, 0x61, 0x31, 0xf0, 0x1b, 0xb4, 0xdf, 0x2b, 0xdc, 0x71, 0x2e, 0x35,
+	0x5d, 0xaf, 0xcb, 0xbc, 0xcb, 0x1e, 0x0f, 0x13, 0xa3, 0x5b, 0x2a, 0x4e, 0xad, 0x67, 0xdd, 0x5f,
+	0x2e, 0x1d, 0x8f, 0xc6, 0x3c, 0x7c, 0x8a, 0x16, 0x6c, 0x25, 0x96, 0x89, 0x92, 0xc2, 0x94, 0x7d,
+	0xa5, 0xff, 0x6d, 0x8d, 0x65, 0x59, 0x36, 0xbb, 0x2c, 0xa5, 0x26, 0xf0, 0x77, 0x68, 0x39, 0x0e,
+	0x85, 0x30, 0x85, 0x0c, 0x91, 0x04, 0xd9, 0x98, 0xde, 0x7d, 0xbe, 0x97, 0x37, 0xc0, 0xce, 0xd3,
+	0xca, 0x67, 0x42, 0x3c, 0xb1, 0x8e, 0xf7, 0x91, 0xf9, 0x06, 0xec, 0x76, 0x69, 0x24, 0x99, 0x0f,
+	0xfa, 0xe4, 0x63, 0xa7, 0xa2, 0x17, 0x5f, 0xc3, 0x9a, 0x2a, 0xc9, 0x02, 0xc9, 0x7e, 0x91, 0xba,
+	0x0e, 0xb6, 0x74, 0x49, 0xda, 0x56, 0xf9, 0xdb, 0xd1, 0xc5, 0x30, 0x0e, 0xd4, 0xa3, 0x7d, 0xc1,
+	0x7c, 0xb2, 0x6d, 0x07, 0x3a, 0x87, 0x35, 0xa5, 0xb4, 0x34, 0xea, 0xea, 0xd1, 0x97, 0xd5, 0xad,
+	0x20, 0x0f, 0x6a, 0xb3, 0x07, 0x77, 0x9d, 0x55, 0x8d, 0xc2, 0xc4, 0xcb, 0x4a, 0x55, 0x60, 0x0f,
+	0x6d, 0xf7, 0x68, 0x7a, 0x69, 0x75, 0x3e, 0xad, 0x88, 0x74, 0xe5, 0x08, 0x52, 0x85, 0x83, 0x7a,
+	0x30, 0xdd, 0xfa, 0x8e, 0x80, 0x06, 0xd5, 0x60, 0x0e, 0x88, 0x68, 0x47, 0x53, 0xb0, 0xc0, 0xaf,
+	0xd1, 0x52, 0x26, 0x62, 0x3b, 0x7d, 0xef, 0x92, 0xc9, 0x4c, 0x84, 0x6c, 0x5c, 0x23, 0x60, 0x9b,
+	0xc0, 0x30, 0x3e, 0x17, 0xb9, 0xbd, 0xa8, 0x66, 0xd2, 0x46, 0x8f, 0xe9, 0xf7, 0x30, 0xd9, 0x13,
+	0x95, 0x14, 0x51, 0x3e, 0x77, 0x0b, 0x23, 0x58, 0x93, 0x8b, 0x0d, 0xd2, 0xf8, 0x5e, 0xef, 0x5d,
+	0x07, 0x0a, 0xfc, 0x3d, 0x22, 0x13, 0x73, 0x4f, 0xf4, 0xe3, 0x98, 0xa6, 0x21, 0x53, 0xfa, 0x45,
+	0x85, 0xa8, 0xdd, 0x38, 0xf4, 0x5a, 0xc0, 0x1c, 0x65, 0x11, 0xd8, 0x34, 0x16, 0x32, 0x81, 0x5f,
+	0x20, 0x3d, 0x82, 0x5c, 0x26, 0xbc, 0x94, 0x5f, 0x29, 0xc5, 0xa3, 0xdc, 0xae, 0x5f, 0x33, 0xb1,
+	0x2e, 0x59, 0x92, 0x55, 0x35, 0x98, 0x9c, 0x68, 0x0b, 0xdc, 0x47, 0xbb, 0x1d, 0xaa, 0x8b, 0x49,
+	0xbf, 0x32, 0x36, 0x08, 0x7d, 0x96, 0x78, 0xcc, 0x55, 0xdf, 0x6c, 0x06, 0x30, 0xc2, 0x41, 0x1e,
+	0x95, 0x0f, 0x3f, 0xb5, 0xdd, 0x36, 0xe9, 0xb8, 0x82, 0x4f, 0x8c, 0xc9, 0x8b, 0xdc, 0xc2, 0xa9,
+	0x76, 0xfe, 0x2f, 0xbe, 0x17, 0xa2, 0x8d, 0x1b, 0xbb, 0x04, 0xfe, 0x0d, 0x5a, 0xce, 0xfb, 0x4b,
+	0xfe, 0xd3, 0x92, 0xfe, 0xe1, 0xea, 0x5e, 0x0e, 0x64, 0xbf, 0x2a, 0xed, 0xa0, 0xf2, 0xb4, 0xfe,
+	0x41, 0x2c, 0x77, 0xbc, 0xc7, 0xd1, 0xc6, 0x8d, 0x4f, 0xf2, 0xc3, 0x42, 0x3d, 0x52, 0x12, 0x18,
+	0x3a, 0x40, 0x98, 0xf8, 0x6c, 0xc8, 0x04, 0xb9, 0x53, 0x9b, 0x55, 0xbf, 0x5f, 0xe9, 0xd5, 0x53,
+	0xbd, 0xb8, 0xf7, 0xb6, 0x84, 0x16, 0x0a, 0x35, 0x88, 0x57, 0xd1, 0x47, 0x20, 0x29, 0x8c, 0x67,
+	0xfd, 0x01, 0xef, 0xa2, 0x8a, 0x90, 0x34, 0x95, 0x59, 0x2f, 0xd6, 0xa9, 0x97, 0x61, 0xcd, 0xb4,
+	0xdf, 0x97, 0x68, 0x9e, 0xc6, 0xbc, 0x9f, 0x48, 0x32, 0xab, 0x2c, 0x3f, 0xe8, 0x0b, 0xc8, 0x69,
+	0x22, 0x1d, 0x63, 0xbd, 0xf7, 0x1c, 0x55, 0x6c, 0xe5, 0xa2, 0x12, 0x82, 0x2a, 0xc8, 0x12, 0x82,
+	0x0f, 0xe3, 0x34, 0xef, 0x58, 0x69, 0x36, 0xff, 0xfc, 0xd3, 0xbb, 0x6a, 0xe9, 0xe7, 0x77, 0xd5,
+	0xd2, 0x7f, 0xde, 0x55, 0x4b, 0x6f, 0xdf, 0x57, 0x67, 0x7e, 0x7e, 0x5f, 0x9d, 0xf9, 0xe7, 0xfb,
+	0xea, 0xcc, 0x5f, 0x9f, 0x4d, 0x67, 0x61, 0x2a, 0xe4, 0xb1, 0xee, 0x28, 0x8d, 0x98, 0xfb, 0xfd,
+	0x88, 0x35, 0x86, 0xd9, 0xba, 0x4e, 0xad, 0x33, 0x0f, 0x72, 0xf1, 0xb7, 0xff, 0x1b, 0x00, 0xb4,
+	0xcc, 0x62, 0x79, 0x2e, 0x15, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
 func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
@@ -461,6 +1044,206 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TokenTransferGas) > 0 {
+		for iNdEx := len(m.TokenTransferGas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenTransferGas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.BatchTransferGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchTransferGas))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.BatchBaseGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchBaseGas))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxBatchGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBatchGas))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MaxOutstandingBatches != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxOutstandingBatches))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.MinBatchFees) > 0 {
+		for iNdEx := len(m.MinBatchFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinBatchFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.BatchCreationInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchCreationInterval))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if len(m.EventVoteThresholds) > 0 {
+		for iNdEx := len(m.EventVoteThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EventVoteThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	{
+		size := m.EventVoteThreshold.Size()
+		i -= size
+		if _, err := m.EventVoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	if len(m.WithdrawalGuardian) > 0 {
+		i -= len(m.WithdrawalGuardian)
+		copy(dAtA[i:], m.WithdrawalGuardian)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.WithdrawalGuardian)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.LargeWithdrawalDelay != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LargeWithdrawalDelay))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if len(m.LargeWithdrawalThresholds) > 0 {
+		for iNdEx := len(m.LargeWithdrawalThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LargeWithdrawalThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.OutflowRateLimits) > 0 {
+		for iNdEx := len(m.OutflowRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutflowRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	if m.SigningInfoWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SigningInfoWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	{
+		size := m.SlashFractionContractCallTx.Size()
+		i -= size
+		if _, err := m.SlashFractionContractCallTx.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if m.SignedContractCallTxsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedContractCallTxsWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	{
+		size := m.SlashFractionBadEthereumSignature.Size()
+		i -= size
+		if _, err := m.SlashFractionBadEthereumSignature.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
 	if m.UnbondSlashingSignerSetTxsWindow != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingSignerSetTxsWindow))
 		i--
@@ -589,10 +1372,24 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.UnbatchedSendToEthereumTxs) > 0 {
-		for iNdEx := len(m.UnbatchedSendToEthereumTxs) - 1; iNdEx >= 0; iNdEx-- {
+	if m.BadSignatureEvidenceActivation != nil {
+		{
+			size, err := m.BadSignatureEvidenceActivation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Erc20Escrows) > 0 {
+		for iNdEx := len(m.Erc20Escrows) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.UnbatchedSendToEthereumTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Erc20Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -600,13 +1397,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x62
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
 		}
 	}
-	if len(m.Erc20ToDenoms) > 0 {
-		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.EthereumEventSummaries) > 0 {
+		for iNdEx := len(m.EthereumEventSummaries) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.EthereumEventSummaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -614,7 +1413,255 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x5a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.PendingSendToEthereums) > 0 {
+		for iNdEx := len(m.PendingSendToEthereums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSendToEthereums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.OutflowBuckets) > 0 {
+		for iNdEx := len(m.OutflowBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutflowBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.ParkedSendToCosmosEvents) > 0 {
+		for iNdEx := len(m.ParkedSendToCosmosEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParkedSendToCosmosEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.PausedTokenContracts) > 0 {
+		for iNdEx := len(m.PausedTokenContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedTokenContracts[iNdEx])
+			copy(dAtA[i:], m.PausedTokenContracts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PausedTokenContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if m.BridgePaused {
+		i--
+		if m.BridgePaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.BridgeHaltedSignerSetNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeHaltedSignerSetNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.BridgeHalted {
+		i--
+		if m.BridgeHalted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.MissedSignatures) > 0 {
+		for iNdEx := len(m.MissedSignatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedSignatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.SigningInfos) > 0 {
+		for iNdEx := len(m.SigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.PastEthereumSignatureCheckpoints) > 0 {
+		for iNdEx := len(m.PastEthereumSignatureCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PastEthereumSignatureCheckpoints[iNdEx])
+			copy(dAtA[i:], m.PastEthereumSignatureCheckpoints[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PastEthereumSignatureCheckpoints[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.LastEventNoncesByValidator) > 0 {
+		for iNdEx := len(m.LastEventNoncesByValidator) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastEventNoncesByValidator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.LastUnbondingBlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastUnbondingBlockHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.LastSlashedEventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedEventNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.LastSlashedContractCallTxBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedContractCallTxBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.LastSlashedOutgoingTxBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedOutgoingTxBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size, err := m.LastEthereumBlockHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.LastObservedSignerSet != nil {
+		{
+			size, err := m.LastObservedSignerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.LatestSignerSetTxNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LatestSignerSetTxNonce))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.LastSendToEthereumId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSendToEthereumId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.LastOutgoingBatchNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastOutgoingBatchNonce))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.UnbatchedSendToEthereumTxs) > 0 {
+		for iNdEx := len(m.UnbatchedSendToEthereumTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbatchedSendToEthereumTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
 		}
 	}
 	if len(m.DelegateKeys) > 0 {
@@ -693,6 +1740,134 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LastEventNonceByValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastEventNonceByValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastEventNonceByValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorMissedSignatures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorMissedSignatures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorMissedSignatures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissedIndexes) > 0 {
+		dAtA6 := make([]byte, len(m.MissedIndexes)*10)
+		var j5 int
+		for _, num := range m.MissedIndexes {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintGenesis(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutflowBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutflowBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutflowBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.StartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -791,10 +1966,79 @@ func (m *Params) Size() (n int) {
 	if m.UnbondSlashingSignerSetTxsWindow != 0 {
 		n += 2 + sovGenesis(uint64(m.UnbondSlashingSignerSetTxsWindow))
 	}
-	return n
-}
-
-func (m *GenesisState) Size() (n int) {
+	l = m.SlashFractionBadEthereumSignature.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.SignedContractCallTxsWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.SignedContractCallTxsWindow))
+	}
+	l = m.SlashFractionContractCallTx.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.SigningInfoWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.SigningInfoWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.OutflowRateLimits) > 0 {
+		for _, e := range m.OutflowRateLimits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LargeWithdrawalThresholds) > 0 {
+		for _, e := range m.LargeWithdrawalThresholds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LargeWithdrawalDelay != 0 {
+		n += 2 + sovGenesis(uint64(m.LargeWithdrawalDelay))
+	}
+	l = len(m.WithdrawalGuardian)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	l = m.EventVoteThreshold.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.EventVoteThresholds) > 0 {
+		for _, e := range m.EventVoteThresholds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BatchCreationInterval != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchCreationInterval))
+	}
+	if m.MaxBatchSize != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxBatchSize))
+	}
+	if len(m.MinBatchFees) > 0 {
+		for _, e := range m.MinBatchFees {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxOutstandingBatches != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxOutstandingBatches))
+	}
+	if m.MaxBatchGas != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxBatchGas))
+	}
+	if m.BatchBaseGas != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchBaseGas))
+	}
+	if m.BatchTransferGas != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchTransferGas))
+	}
+	if len(m.TokenTransferGas) > 0 {
+		for _, e := range m.TokenTransferGas {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -843,6 +2087,160 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.LastOutgoingBatchNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastOutgoingBatchNonce))
+	}
+	if m.LastSendToEthereumId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSendToEthereumId))
+	}
+	if m.LatestSignerSetTxNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LatestSignerSetTxNonce))
+	}
+	if m.LastObservedSignerSet != nil {
+		l = m.LastObservedSignerSet.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	l = m.LastEthereumBlockHeight.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.LastSlashedOutgoingTxBlock != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSlashedOutgoingTxBlock))
+	}
+	if m.LastSlashedContractCallTxBlock != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSlashedContractCallTxBlock))
+	}
+	if m.LastSlashedEventNonce != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSlashedEventNonce))
+	}
+	if m.LastUnbondingBlockHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.LastUnbondingBlockHeight))
+	}
+	if len(m.LastEventNoncesByValidator) > 0 {
+		for _, e := range m.LastEventNoncesByValidator {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PastEthereumSignatureCheckpoints) > 0 {
+		for _, b := range m.PastEthereumSignatureCheckpoints {
+			l = len(b)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SigningInfos) > 0 {
+		for _, e := range m.SigningInfos {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissedSignatures) > 0 {
+		for _, e := range m.MissedSignatures {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BridgeHalted {
+		n += 3
+	}
+	if m.BridgeHaltedSignerSetNonce != 0 {
+		n += 2 + sovGenesis(uint64(m.BridgeHaltedSignerSetNonce))
+	}
+	if m.BridgePaused {
+		n += 3
+	}
+	if len(m.PausedTokenContracts) > 0 {
+		for _, s := range m.PausedTokenContracts {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ParkedSendToCosmosEvents) > 0 {
+		for _, e := range m.ParkedSendToCosmosEvents {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OutflowBuckets) > 0 {
+		for _, e := range m.OutflowBuckets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingSendToEthereums) > 0 {
+		for _, e := range m.PendingSendToEthereums {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EthereumEventSummaries) > 0 {
+		for _, e := range m.EthereumEventSummaries {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20Escrows) > 0 {
+		for _, e := range m.Erc20Escrows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BadSignatureEvidenceActivation != nil {
+		l = m.BadSignatureEvidenceActivation.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *LastEventNonceByValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.EventNonce))
+	}
+	return n
+}
+
+func (m *ValidatorMissedSignatures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MissedIndexes) > 0 {
+		l = 0
+		for _, e := range m.MissedIndexes {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *OutflowBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.StartHeight))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -882,27 +2280,1317 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			m.BridgeChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedSignerSetTxsWindow", wireType)
+			}
+			m.SignedSignerSetTxsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			}
+			m.SignedBatchesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSignaturesWindow", wireType)
+			}
+			m.EthereumSignaturesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumSignaturesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetEthTxTimeout", wireType)
+			}
+			m.TargetEthTxTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetEthTxTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			}
+			m.AverageBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			}
+			m.AverageEthereumBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionSignerSetTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionSignerSetTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionEthereumSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingEthereumSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionConflictingEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingSignerSetTxsWindow", wireType)
+			}
+			m.UnbondSlashingSignerSetTxsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondSlashingSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBadEthereumSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBadEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedContractCallTxsWindow", wireType)
+			}
+			m.SignedContractCallTxsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedContractCallTxsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionContractCallTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionContractCallTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfoWindow", wireType)
+			}
+			m.SigningInfoWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigningInfoWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutflowRateLimits = append(m.OutflowRateLimits, OutflowRateLimit{})
+			if err := m.OutflowRateLimits[len(m.OutflowRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LargeWithdrawalThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LargeWithdrawalThresholds = append(m.LargeWithdrawalThresholds, LargeWithdrawalThreshold{})
+			if err := m.LargeWithdrawalThresholds[len(m.LargeWithdrawalThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LargeWithdrawalDelay", wireType)
+			}
+			m.LargeWithdrawalDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LargeWithdrawalDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalGuardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalGuardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventVoteThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EventVoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventVoteThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventVoteThresholds = append(m.EventVoteThresholds, EventVoteThreshold{})
+			if err := m.EventVoteThresholds[len(m.EventVoteThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchCreationInterval", wireType)
+			}
+			m.BatchCreationInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchCreationInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+			}
+			m.MaxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBatchFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinBatchFees = append(m.MinBatchFees, MinBatchFee{})
+			if err := m.MinBatchFees[len(m.MinBatchFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutstandingBatches", wireType)
+			}
+			m.MaxOutstandingBatches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOutstandingBatches |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchGas", wireType)
+			}
+			m.MaxBatchGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchBaseGas", wireType)
+			}
+			m.BatchBaseGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchBaseGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTransferGas", wireType)
+			}
+			m.BatchTransferGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchTransferGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenTransferGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenTransferGas = append(m.TokenTransferGas, TokenTransferGas{})
+			if err := m.TokenTransferGas[len(m.TokenTransferGas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEventNonce", wireType)
+			}
+			m.LastObservedEventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastObservedEventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutgoingTxs = append(m.OutgoingTxs, &types.Any{})
+			if err := m.OutgoingTxs[len(m.OutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Confirmations = append(m.Confirmations, &types.Any{})
+			if err := m.Confirmations[len(m.Confirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumEventVoteRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumEventVoteRecords = append(m.EthereumEventVoteRecords, &EthereumEventVoteRecord{})
+			if err := m.EthereumEventVoteRecords[len(m.EthereumEventVoteRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateKeys = append(m.DelegateKeys, &MsgDelegateKeys{})
+			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20ToDenoms = append(m.Erc20ToDenoms, &ERC20ToDenom{})
+			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedSendToEthereumTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbatchedSendToEthereumTxs = append(m.UnbatchedSendToEthereumTxs, &SendToEthereum{})
+			if err := m.UnbatchedSendToEthereumTxs[len(m.UnbatchedSendToEthereumTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOutgoingBatchNonce", wireType)
+			}
+			m.LastOutgoingBatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastOutgoingBatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSendToEthereumId", wireType)
+			}
+			m.LastSendToEthereumId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSendToEthereumId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestSignerSetTxNonce", wireType)
+			}
+			m.LatestSignerSetTxNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestSignerSetTxNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedSignerSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastObservedSignerSet == nil {
+				m.LastObservedSignerSet = &SignerSetTx{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.LastObservedSignerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEthereumBlockHeight", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -912,29 +3600,106 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GravityId = string(dAtA[iNdEx:postIndex])
+			if err := m.LastEthereumBlockHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedOutgoingTxBlock", wireType)
+			}
+			m.LastSlashedOutgoingTxBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSlashedOutgoingTxBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedContractCallTxBlock", wireType)
+			}
+			m.LastSlashedContractCallTxBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSlashedContractCallTxBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedEventNonce", wireType)
+			}
+			m.LastSlashedEventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSlashedEventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUnbondingBlockHeight", wireType)
+			}
+			m.LastUnbondingBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUnbondingBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventNoncesByValidator", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -944,29 +3709,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			m.LastEventNoncesByValidator = append(m.LastEventNoncesByValidator, LastEventNonceByValidator{})
+			if err := m.LastEventNoncesByValidator[len(m.LastEventNoncesByValidator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PastEthereumSignatureCheckpoints", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -976,29 +3743,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			m.PastEthereumSignatureCheckpoints = append(m.PastEthereumSignatureCheckpoints, make([]byte, postIndex-iNdEx))
+			copy(m.PastEthereumSignatureCheckpoints[len(m.PastEthereumSignatureCheckpoints)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
 			}
-			m.BridgeChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1008,16 +3775,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeChainId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedSignerSetTxsWindow", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.SignedSignerSetTxsWindow = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningInfos = append(m.SigningInfos, GravitySigningInfo{})
+			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedSignatures", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1027,16 +3809,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedSignatures = append(m.MissedSignatures, ValidatorMissedSignatures{})
+			if err := m.MissedSignatures[len(m.MissedSignatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeHalted", wireType)
 			}
-			m.SignedBatchesWindow = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1046,16 +3843,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			m.BridgeHalted = bool(v != 0)
+		case 27:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSignaturesWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeHaltedSignerSetNonce", wireType)
 			}
-			m.EthereumSignaturesWindow = 0
+			m.BridgeHaltedSignerSetNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1065,16 +3863,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumSignaturesWindow |= uint64(b&0x7F) << shift
+				m.BridgeHaltedSignerSetNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 28:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetEthTxTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgePaused", wireType)
 			}
-			m.TargetEthTxTimeout = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1084,16 +3882,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetEthTxTimeout |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			m.BridgePaused = bool(v != 0)
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedTokenContracts", wireType)
 			}
-			m.AverageBlockTime = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1103,16 +3902,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.AverageEthereumBlockTime = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedTokenContracts = append(m.PausedTokenContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParkedSendToCosmosEvents", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1122,16 +3934,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParkedSendToCosmosEvents = append(m.ParkedSendToCosmosEvents, SendToCosmosEvent{})
+			if err := m.ParkedSendToCosmosEvents[len(m.ParkedSendToCosmosEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionSignerSetTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowBuckets", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1141,30 +3968,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionSignerSetTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OutflowBuckets = append(m.OutflowBuckets, OutflowBucket{})
+			if err := m.OutflowBuckets[len(m.OutflowBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSendToEthereums", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1174,30 +4002,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingSendToEthereums = append(m.PendingSendToEthereums, PendingSendToEthereum{})
+			if err := m.PendingSendToEthereums[len(m.PendingSendToEthereums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionEthereumSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumEventSummaries", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1207,30 +4036,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EthereumEventSummaries = append(m.EthereumEventSummaries, EthereumEventSummary{})
+			if err := m.EthereumEventSummaries[len(m.EthereumEventSummaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingEthereumSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Escrows", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1240,30 +4070,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionConflictingEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Erc20Escrows = append(m.Erc20Escrows, ERC20Token{})
+			if err := m.Erc20Escrows[len(m.Erc20Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingSignerSetTxsWindow", wireType)
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadSignatureEvidenceActivation", wireType)
 			}
-			m.UnbondSlashingSignerSetTxsWindow = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1273,11 +4104,28 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondSlashingSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BadSignatureEvidenceActivation == nil {
+				m.BadSignatureEvidenceActivation = &BadSignatureEvidenceActivation{}
+			}
+			if err := m.BadSignatureEvidenceActivation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1302,7 +4150,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *LastEventNonceByValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1325,17 +4173,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: LastEventNonceByValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LastEventNonceByValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1345,33 +4193,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEventNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			m.LastObservedEventNonce = 0
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1381,50 +4225,69 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastObservedEventNonce |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutgoingTxs = append(m.OutgoingTxs, &types.Any{})
-			if err := m.OutgoingTxs[len(m.OutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorMissedSignatures) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorMissedSignatures: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorMissedSignatures: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1434,65 +4297,158 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Confirmations = append(m.Confirmations, &types.Any{})
-			if err := m.Confirmations[len(m.Confirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumEventVoteRecords", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.MissedIndexes = append(m.MissedIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MissedIndexes) == 0 {
+					m.MissedIndexes = make([]uint64, 0, elementCount)
 				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissedIndexes = append(m.MissedIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedIndexes", wireType)
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumEventVoteRecords = append(m.EthereumEventVoteRecords, &EthereumEventVoteRecord{})
-			if err := m.EthereumEventVoteRecords[len(m.EthereumEventVoteRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutflowBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 10:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutflowBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutflowBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1502,31 +4458,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateKeys = append(m.DelegateKeys, &MsgDelegateKeys{})
-			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var msglen int
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1536,31 +4490,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StartHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Erc20ToDenoms = append(m.Erc20ToDenoms, &ERC20ToDenom{})
-			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedSendToEthereumTxs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1570,23 +4509,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbatchedSendToEthereumTxs = append(m.UnbatchedSendToEthereumTxs, &SendToEthereum{})
-			if err := m.UnbatchedSendToEthereumTxs[len(m.UnbatchedSendToEthereumTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
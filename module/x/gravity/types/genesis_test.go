@@ -1,8 +1,16 @@
 package types
 
 import (
+	"bytes"
+	"math"
+	"strings"
 	"testing"
+	"time"
 
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
 
@@ -34,6 +42,133 @@ func TestGenesisStateValidate(t *testing.T) {
 	}
 }
 
+func TestGenesisStateValidateState(t *testing.T) {
+	var (
+		valAddr      = sdk.ValAddress(bytes.Repeat([]byte{0x1}, 20))
+		orchAddr     = sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20))
+		ethAddr      = common.HexToAddress("0x3146D2d6Eed46Afa423969f5dDC3152DfC359b09")
+		contract     = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		recipient    = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		delegateKeys = []*MsgDelegateKeys{{
+			ValidatorAddress:    valAddr.String(),
+			OrchestratorAddress: orchAddr.String(),
+			EthereumAddress:     ethAddr.Hex(),
+		}}
+	)
+
+	send := func(id uint64) *SendToEthereum {
+		return &SendToEthereum{
+			Id:                id,
+			Sender:            orchAddr.String(),
+			EthereumRecipient: recipient,
+			Erc20Token:        NewERC20Token(100, contract),
+			Erc20Fee:          NewERC20Token(1, contract),
+		}
+	}
+	pack := func(otx OutgoingTx) *cdctypes.Any {
+		any, err := PackOutgoingTx(otx)
+		require.NoError(t, err)
+		return any
+	}
+	packConfirmation := func(conf EthereumTxConfirmation) *cdctypes.Any {
+		any, err := PackConfirmation(conf)
+		require.NoError(t, err)
+		return any
+	}
+	record := func(nonce uint64, amount int64, accepted bool, voter string) *EthereumEventVoteRecord {
+		any, err := PackEvent(&SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  contract,
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: recipient,
+			CosmosReceiver: orchAddr.String(),
+			EthereumHeight: 10,
+		})
+		require.NoError(t, err)
+		return &EthereumEventVoteRecord{Event: any, Accepted: accepted, Votes: []EthereumEventVote{{Validator: voter, Power: 1}}}
+	}
+	valid := func() *GenesisState {
+		return &GenesisState{
+			Params:                 DefaultParams(),
+			LastObservedEventNonce: 1,
+			OutgoingTxs: []*cdctypes.Any{
+				pack(&SignerSetTx{Nonce: 1, Signers: EthereumSigners{{Power: 1, EthereumAddress: ethAddr.Hex()}}}),
+				pack(&BatchTx{BatchNonce: 1, TokenContract: contract, Transactions: []*SendToEthereum{send(1)}}),
+			},
+			Confirmations: []*cdctypes.Any{
+				packConfirmation(&SignerSetTxConfirmation{SignerSetNonce: 1, EthereumSigner: ethAddr.Hex(), Signature: []byte("signature")}),
+			},
+			EthereumEventVoteRecords: []*EthereumEventVoteRecord{
+				record(1, 100, true, valAddr.String()),
+				record(1, 200, false, valAddr.String()),
+				record(2, 100, false, valAddr.String()),
+			},
+			DelegateKeys:               delegateKeys,
+			UnbatchedSendToEthereumTxs: []*SendToEthereum{send(2)},
+		}
+	}
+
+	specs := map[string]struct {
+		malleate func(*GenesisState)
+		expErr   bool
+	}{
+		"valid": {malleate: func(*GenesisState) {}},
+		"invalid outgoing tx": {malleate: func(gs *GenesisState) {
+			gs.OutgoingTxs = append(gs.OutgoingTxs, pack(&ContractCallTx{InvalidationNonce: 1, Address: recipient}))
+		}, expErr: true},
+		"duplicate outgoing tx": {malleate: func(gs *GenesisState) {
+			gs.OutgoingTxs = append(gs.OutgoingTxs, pack(&SignerSetTx{Nonce: 1}))
+		}, expErr: true},
+		"confirmation without outgoing tx": {malleate: func(gs *GenesisState) {
+			gs.Confirmations = append(gs.Confirmations, packConfirmation(&SignerSetTxConfirmation{SignerSetNonce: 2, EthereumSigner: ethAddr.Hex(), Signature: []byte("signature")}))
+		}, expErr: true},
+		"confirmation without delegate keys": {malleate: func(gs *GenesisState) {
+			gs.Confirmations = append(gs.Confirmations, packConfirmation(&BatchTxConfirmation{TokenContract: contract, BatchNonce: 1, EthereumSigner: recipient, Signature: []byte("signature")}))
+		}, expErr: true},
+		"invalid voter": {malleate: func(gs *GenesisState) {
+			gs.EthereumEventVoteRecords = append(gs.EthereumEventVoteRecords, record(2, 200, false, orchAddr.String()))
+		}, expErr: true},
+		"observed after last observed event nonce": {malleate: func(gs *GenesisState) {
+			gs.EthereumEventVoteRecords[2].Accepted = true
+		}, expErr: true},
+		"observed twice at a nonce": {malleate: func(gs *GenesisState) {
+			gs.EthereumEventVoteRecords[1].Accepted = true
+		}, expErr: true},
+		"duplicate unbatched send": {malleate: func(gs *GenesisState) {
+			gs.UnbatchedSendToEthereumTxs = append(gs.UnbatchedSendToEthereumTxs, send(2))
+		}, expErr: true},
+		"unbatched send in a batch": {malleate: func(gs *GenesisState) {
+			gs.UnbatchedSendToEthereumTxs = append(gs.UnbatchedSendToEthereumTxs, send(1))
+		}, expErr: true},
+		"orchestrator delegated twice": {malleate: func(gs *GenesisState) {
+			gs.DelegateKeys = append(gs.DelegateKeys, &MsgDelegateKeys{
+				ValidatorAddress:    sdk.ValAddress(bytes.Repeat([]byte{0x3}, 20)).String(),
+				OrchestratorAddress: orchAddr.String(),
+				EthereumAddress:     recipient,
+			})
+		}, expErr: true},
+		"ethereum address delegated twice": {malleate: func(gs *GenesisState) {
+			gs.DelegateKeys = append(gs.DelegateKeys, &MsgDelegateKeys{
+				ValidatorAddress:    sdk.ValAddress(bytes.Repeat([]byte{0x3}, 20)).String(),
+				OrchestratorAddress: sdk.AccAddress(bytes.Repeat([]byte{0x4}, 20)).String(),
+				EthereumAddress:     ethAddr.Hex(),
+			})
+		}, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			gs := valid()
+			spec.malleate(gs)
+			err := gs.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestStringToByteArray(t *testing.T) {
 	specs := map[string]struct {
 		testString string
@@ -55,3 +190,154 @@ func TestStringToByteArray(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateOutflowRateLimits(t *testing.T) {
+	specs := map[string]struct {
+		src    []OutflowRateLimit
+		expErr bool
+	}{
+		"none":           {src: []OutflowRateLimit{}},
+		"valid":          {src: []OutflowRateLimit{{Denom: "stake", MaxAmount: sdk.NewInt(100), Window: 10}}},
+		"invalid denom":  {src: []OutflowRateLimit{{Denom: "1", MaxAmount: sdk.NewInt(100), Window: 10}}, expErr: true},
+		"nil max amount": {src: []OutflowRateLimit{{Denom: "stake", Window: 10}}, expErr: true},
+		"negative":       {src: []OutflowRateLimit{{Denom: "stake", MaxAmount: sdk.NewInt(-1), Window: 10}}, expErr: true},
+		"zero window":    {src: []OutflowRateLimit{{Denom: "stake", MaxAmount: sdk.NewInt(100)}}, expErr: true},
+		"duplicate denom": {src: []OutflowRateLimit{
+			{Denom: "stake", MaxAmount: sdk.NewInt(100), Window: 10},
+			{Denom: "stake", MaxAmount: sdk.NewInt(200), Window: 10},
+		}, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := validateOutflowRateLimits(spec.src)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateEventVoteThresholds(t *testing.T) {
+	specs := map[string]struct {
+		src    []EventVoteThreshold
+		expErr bool
+	}{
+		"none":               {src: []EventVoteThreshold{}},
+		"valid":              {src: []EventVoteThreshold{{EventType: "gravity.v1.ERC20DeployedEvent", Threshold: sdk.NewDecWithPrec(9, 1)}}},
+		"unknown event type": {src: []EventVoteThreshold{{EventType: "gravity.v1.Params", Threshold: sdk.NewDecWithPrec(9, 1)}}, expErr: true},
+		"nil threshold":      {src: []EventVoteThreshold{{EventType: "gravity.v1.ERC20DeployedEvent"}}, expErr: true},
+		"half":               {src: []EventVoteThreshold{{EventType: "gravity.v1.ERC20DeployedEvent", Threshold: sdk.NewDecWithPrec(5, 1)}}, expErr: true},
+		"above one":          {src: []EventVoteThreshold{{EventType: "gravity.v1.ERC20DeployedEvent", Threshold: sdk.NewDecWithPrec(11, 1)}}, expErr: true},
+		"duplicate event type": {src: []EventVoteThreshold{
+			{EventType: "gravity.v1.ERC20DeployedEvent", Threshold: sdk.NewDecWithPrec(9, 1)},
+			{EventType: "gravity.v1.ERC20DeployedEvent", Threshold: sdk.NewDecWithPrec(8, 1)},
+		}, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := validateEventVoteThresholds(spec.src)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateMinBatchFees(t *testing.T) {
+	const contract = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	specs := map[string]struct {
+		src    []MinBatchFee
+		expErr bool
+	}{
+		"none":             {src: []MinBatchFee{}},
+		"valid":            {src: []MinBatchFee{{TokenContract: contract, Amount: sdk.NewInt(100)}}},
+		"invalid contract": {src: []MinBatchFee{{TokenContract: "0x1", Amount: sdk.NewInt(100)}}, expErr: true},
+		"nil amount":       {src: []MinBatchFee{{TokenContract: contract}}, expErr: true},
+		"negative":         {src: []MinBatchFee{{TokenContract: contract, Amount: sdk.NewInt(-1)}}, expErr: true},
+		"duplicate contract": {src: []MinBatchFee{
+			{TokenContract: contract, Amount: sdk.NewInt(100)},
+			{TokenContract: strings.ToLower(contract), Amount: sdk.NewInt(200)},
+		}, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := validateMinBatchFees(spec.src)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateTokenTransferGas(t *testing.T) {
+	const contract = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	specs := map[string]struct {
+		src    []TokenTransferGas
+		expErr bool
+	}{
+		"none":              {src: []TokenTransferGas{}},
+		"valid":             {src: []TokenTransferGas{{TokenContract: contract, TransferGas: 100000}}},
+		"invalid contract":  {src: []TokenTransferGas{{TokenContract: "0x1", TransferGas: 100000}}, expErr: true},
+		"zero transfer gas": {src: []TokenTransferGas{{TokenContract: contract}}, expErr: true},
+		"duplicate contract": {src: []TokenTransferGas{
+			{TokenContract: contract, TransferGas: 100000},
+			{TokenContract: strings.ToLower(contract), TransferGas: 200000},
+		}, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := validateTokenTransferGas(spec.src)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDefaultLargeWithdrawalDelayOutlastsVotingPeriod(t *testing.T) {
+	// a cancel proposal submitted when a send is held has to pass before the send is released
+	params := DefaultParams()
+	delay := time.Duration(params.LargeWithdrawalDelay*params.AverageBlockTime) * time.Millisecond
+	require.Greater(t, int64(delay), int64(govtypes.DefaultPeriod))
+}
+
+func TestBatchTxGas(t *testing.T) {
+	contract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	expensive := common.HexToAddress("0x7c2C195CD6D34B8F845992d380aADB2730bB9C6F")
+
+	params := DefaultParams()
+	params.MaxBatchGas = 1000
+	params.BatchBaseGas = 100
+	params.BatchTransferGas = 50
+	params.TokenTransferGas = []TokenTransferGas{{TokenContract: expensive.Hex(), TransferGas: 400}}
+	require.NoError(t, params.ValidateBasic())
+
+	require.Equal(t, uint64(50), params.TransferGasOf(contract))
+	require.Equal(t, uint64(400), params.TransferGasOf(expensive))
+	require.Equal(t, uint64(600), params.BatchTxGas(contract, 10))
+	require.Equal(t, uint64(900), params.BatchTxGas(expensive, 2))
+	require.Equal(t, 18, params.MaxBatchTransfers(contract))
+	require.Equal(t, 2, params.MaxBatchTransfers(expensive))
+
+	// a batch tx with a single transfer must fit
+	params.TokenTransferGas[0].TransferGas = 901
+	require.Error(t, params.ValidateBasic())
+	require.Equal(t, 1, params.MaxBatchTransfers(expensive))
+	params.TokenTransferGas = nil
+	params.BatchBaseGas = 1000
+	require.Error(t, params.ValidateBasic())
+
+	// transfers without gas are refused, and wouldn't limit the batch size
+	params.BatchBaseGas = 100
+	params.BatchTransferGas = 0
+	require.Error(t, params.ValidateBasic())
+	require.Equal(t, math.MaxInt32, params.MaxBatchTransfers(contract))
+}
@@ -34,8 +34,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // once the required threshold is met.
 type EthereumEventVoteRecord struct {
 	Event    *types.Any `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
-	Votes    []string   `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
 	Accepted bool       `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// height is the cosmos block height at which the record was accepted
+	Height uint64              `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Votes  []EthereumEventVote `protobuf:"bytes,5,rep,name=votes,proto3" json:"votes"`
+	// vote_power is the sum of the power of the votes
+	VotePower github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=vote_power,json=votePower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"vote_power"`
+	// total_power is the total voting power at creation_height, the record is
+	// accepted once vote_power reaches the event vote threshold of it
+	TotalPower github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=total_power,json=totalPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_power"`
+	// creation_height is the cosmos block height of the first vote
+	CreationHeight uint64 `protobuf:"varint,8,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
 }
 
 func (m *EthereumEventVoteRecord) Reset()         { *m = EthereumEventVoteRecord{} }
@@ -78,18 +87,149 @@ func (m *EthereumEventVoteRecord) GetEvent() *types.Any {
 	return nil
 }
 
-func (m *EthereumEventVoteRecord) GetVotes() []string {
+func (m *EthereumEventVoteRecord) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *EthereumEventVoteRecord) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EthereumEventVoteRecord) GetVotes() []EthereumEventVote {
 	if m != nil {
 		return m.Votes
 	}
 	return nil
 }
 
-func (m *EthereumEventVoteRecord) GetAccepted() bool {
+func (m *EthereumEventVoteRecord) GetCreationHeight() uint64 {
 	if m != nil {
-		return m.Accepted
+		return m.CreationHeight
 	}
-	return false
+	return 0
+}
+
+// EthereumEventVote is the vote of a validator for an Ethereum event with the
+// power it had when it voted
+type EthereumEventVote struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Power     int64  `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *EthereumEventVote) Reset()         { *m = EthereumEventVote{} }
+func (m *EthereumEventVote) String() string { return proto.CompactTextString(m) }
+func (*EthereumEventVote) ProtoMessage()    {}
+func (*EthereumEventVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{1}
+}
+func (m *EthereumEventVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumEventVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumEventVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthereumEventVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumEventVote.Merge(m, src)
+}
+func (m *EthereumEventVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumEventVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumEventVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthereumEventVote proto.InternalMessageInfo
+
+func (m *EthereumEventVote) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *EthereumEventVote) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+// EthereumEventSummary is what is kept of an observed Ethereum event once its
+// vote records are pruned
+type EthereumEventSummary struct {
+	EventNonce uint64                                               `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	EventHash  github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=event_hash,json=eventHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"event_hash,omitempty"`
+	// height is the cosmos block height at which the event was observed
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *EthereumEventSummary) Reset()         { *m = EthereumEventSummary{} }
+func (m *EthereumEventSummary) String() string { return proto.CompactTextString(m) }
+func (*EthereumEventSummary) ProtoMessage()    {}
+func (*EthereumEventSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{2}
+}
+func (m *EthereumEventSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumEventSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumEventSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthereumEventSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumEventSummary.Merge(m, src)
+}
+func (m *EthereumEventSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumEventSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumEventSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthereumEventSummary proto.InternalMessageInfo
+
+func (m *EthereumEventSummary) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *EthereumEventSummary) GetEventHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EventHash
+	}
+	return nil
+}
+
+func (m *EthereumEventSummary) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
 // LatestEthereumBlockHeight defines the latest observed ethereum block height
@@ -103,7 +243,7 @@ func (m *LatestEthereumBlockHeight) Reset()         { *m = LatestEthereumBlockHe
 func (m *LatestEthereumBlockHeight) String() string { return proto.CompactTextString(m) }
 func (*LatestEthereumBlockHeight) ProtoMessage()    {}
 func (*LatestEthereumBlockHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{1}
+	return fileDescriptor_1715a041eadeb531, []int{3}
 }
 func (m *LatestEthereumBlockHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -157,7 +297,7 @@ func (m *EthereumSigner) Reset()         { *m = EthereumSigner{} }
 func (m *EthereumSigner) String() string { return proto.CompactTextString(m) }
 func (*EthereumSigner) ProtoMessage()    {}
 func (*EthereumSigner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{2}
+	return fileDescriptor_1715a041eadeb531, []int{4}
 }
 func (m *EthereumSigner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,7 +354,7 @@ func (m *SignerSetTx) Reset()         { *m = SignerSetTx{} }
 func (m *SignerSetTx) String() string { return proto.CompactTextString(m) }
 func (*SignerSetTx) ProtoMessage()    {}
 func (*SignerSetTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{3}
+	return fileDescriptor_1715a041eadeb531, []int{5}
 }
 func (m *SignerSetTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -273,13 +413,16 @@ type BatchTx struct {
 	Transactions  []*SendToEthereum `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"`
 	TokenContract string            `protobuf:"bytes,4,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
 	Height        uint64            `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// estimated_gas is the gas submitting the batch to the bridge contract is
+	// estimated to cost, see the batch gas params
+	EstimatedGas uint64 `protobuf:"varint,6,opt,name=estimated_gas,json=estimatedGas,proto3" json:"estimated_gas,omitempty"`
 }
 
 func (m *BatchTx) Reset()         { *m = BatchTx{} }
 func (m *BatchTx) String() string { return proto.CompactTextString(m) }
 func (*BatchTx) ProtoMessage()    {}
 func (*BatchTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{4}
+	return fileDescriptor_1715a041eadeb531, []int{6}
 }
 func (m *BatchTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -343,6 +486,13 @@ func (m *BatchTx) GetHeight() uint64 {
 	return 0
 }
 
+func (m *BatchTx) GetEstimatedGas() uint64 {
+	if m != nil {
+		return m.EstimatedGas
+	}
+	return 0
+}
+
 // SendToEthereum represents an individual SendToEthereum from Cosmos to
 // Ethereum
 type SendToEthereum struct {
@@ -351,13 +501,16 @@ type SendToEthereum struct {
 	EthereumRecipient string     `protobuf:"bytes,3,opt,name=ethereum_recipient,json=ethereumRecipient,proto3" json:"ethereum_recipient,omitempty"`
 	Erc20Token        ERC20Token `protobuf:"bytes,4,opt,name=erc20_token,json=erc20Token,proto3" json:"erc20_token"`
 	Erc20Fee          ERC20Token `protobuf:"bytes,5,opt,name=erc20_fee,json=erc20Fee,proto3" json:"erc20_fee"`
+	// expires_at_height is the height at which the send is refunded if it is not
+	// batched, zero if it never expires
+	ExpiresAtHeight uint64 `protobuf:"varint,6,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
 }
 
 func (m *SendToEthereum) Reset()         { *m = SendToEthereum{} }
 func (m *SendToEthereum) String() string { return proto.CompactTextString(m) }
 func (*SendToEthereum) ProtoMessage()    {}
 func (*SendToEthereum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{5}
+	return fileDescriptor_1715a041eadeb531, []int{7}
 }
 func (m *SendToEthereum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -421,6 +574,13 @@ func (m *SendToEthereum) GetErc20Fee() ERC20Token {
 	return ERC20Token{}
 }
 
+func (m *SendToEthereum) GetExpiresAtHeight() uint64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
+}
+
 // ContractCallTx represents an individual arbitrary logic call transaction
 // from Cosmos to Ethereum.
 type ContractCallTx struct {
@@ -438,7 +598,7 @@ func (m *ContractCallTx) Reset()         { *m = ContractCallTx{} }
 func (m *ContractCallTx) String() string { return proto.CompactTextString(m) }
 func (*ContractCallTx) ProtoMessage()    {}
 func (*ContractCallTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{6}
+	return fileDescriptor_1715a041eadeb531, []int{8}
 }
 func (m *ContractCallTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -532,7 +692,7 @@ func (m *ERC20Token) Reset()         { *m = ERC20Token{} }
 func (m *ERC20Token) String() string { return proto.CompactTextString(m) }
 func (*ERC20Token) ProtoMessage()    {}
 func (*ERC20Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{7}
+	return fileDescriptor_1715a041eadeb531, []int{9}
 }
 func (m *ERC20Token) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,7 +736,7 @@ func (m *IDSet) Reset()         { *m = IDSet{} }
 func (m *IDSet) String() string { return proto.CompactTextString(m) }
 func (*IDSet) ProtoMessage()    {}
 func (*IDSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{8}
+	return fileDescriptor_1715a041eadeb531, []int{10}
 }
 func (m *IDSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -612,411 +772,629 @@ func (m *IDSet) GetIds() []uint64 {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*EthereumEventVoteRecord)(nil), "gravity.v1.EthereumEventVoteRecord")
-	proto.RegisterType((*LatestEthereumBlockHeight)(nil), "gravity.v1.LatestEthereumBlockHeight")
-	proto.RegisterType((*EthereumSigner)(nil), "gravity.v1.EthereumSigner")
-	proto.RegisterType((*SignerSetTx)(nil), "gravity.v1.SignerSetTx")
-	proto.RegisterType((*BatchTx)(nil), "gravity.v1.BatchTx")
-	proto.RegisterType((*SendToEthereum)(nil), "gravity.v1.SendToEthereum")
-	proto.RegisterType((*ContractCallTx)(nil), "gravity.v1.ContractCallTx")
-	proto.RegisterType((*ERC20Token)(nil), "gravity.v1.ERC20Token")
-	proto.RegisterType((*IDSet)(nil), "gravity.v1.IDSet")
+// GravitySigningInfo tracks how many outgoing tx signatures and ethereum event
+// votes a validator missed over a sliding window, in the style of the
+// x/slashing signing info. The missed entries themselves are kept in a bit
+// array in the store.
+type GravitySigningInfo struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// index_offset is the number of signatures and votes recorded since the
+	// window was last reset
+	IndexOffset uint64 `protobuf:"varint,2,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// missed_counter is the number of missed signatures and votes in the window
+	MissedCounter uint64 `protobuf:"varint,3,opt,name=missed_counter,json=missedCounter,proto3" json:"missed_counter,omitempty"`
+	// window is the signing info window the missed signatures were recorded
+	// over, the window starts over when the param changes
+	Window uint64 `protobuf:"varint,4,opt,name=window,proto3" json:"window,omitempty"`
 }
 
-func init() { proto.RegisterFile("gravity/v1/gravity.proto", fileDescriptor_1715a041eadeb531) }
-
-var fileDescriptor_1715a041eadeb531 = []byte{
-	// 850 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4f, 0x6f, 0xe3, 0xc4,
-	0x1b, 0xae, 0xf3, 0xaf, 0xed, 0xa4, 0xcd, 0x6e, 0xe7, 0x57, 0xed, 0xcf, 0xed, 0x21, 0x89, 0x82,
-	0x80, 0x70, 0xa8, 0xdd, 0x86, 0x3d, 0x2c, 0x87, 0x45, 0x5a, 0x97, 0x5d, 0x6d, 0x25, 0x84, 0x84,
-	0x13, 0x71, 0xe0, 0x12, 0x8d, 0xed, 0xb7, 0xce, 0xd0, 0xc4, 0x63, 0x79, 0x26, 0x21, 0xf9, 0x02,
-	0x1c, 0x38, 0xf1, 0x39, 0x38, 0xf3, 0x0d, 0xb8, 0xac, 0x38, 0xed, 0x11, 0x71, 0x28, 0xd0, 0x7e,
-	0x07, 0x0e, 0x9c, 0xd0, 0xfc, 0xcb, 0xda, 0x2b, 0x04, 0x9c, 0x3a, 0xcf, 0xf3, 0xbe, 0xef, 0x33,
-	0x8f, 0x1f, 0xbf, 0x75, 0x90, 0x9b, 0x16, 0x64, 0x45, 0xc5, 0xc6, 0x5f, 0x5d, 0xf8, 0xe6, 0xe8,
-	0xe5, 0x05, 0x13, 0x0c, 0x23, 0x0b, 0x57, 0x17, 0xa7, 0x27, 0x31, 0xe3, 0x0b, 0xc6, 0xa7, 0xaa,
-	0xe2, 0x6b, 0xa0, 0xdb, 0x4e, 0x7b, 0x29, 0x63, 0xe9, 0x1c, 0x7c, 0x85, 0xa2, 0xe5, 0xb5, 0x2f,
-	0xe8, 0x02, 0xb8, 0x20, 0x8b, 0xdc, 0x34, 0x1c, 0xa7, 0x2c, 0x65, 0x7a, 0x50, 0x9e, 0x0c, 0xdb,
-	0xd5, 0x22, 0x7e, 0x44, 0x38, 0xf8, 0xab, 0x8b, 0x08, 0x04, 0xb9, 0xf0, 0x63, 0x46, 0x33, 0x53,
-	0x3f, 0x79, 0x5b, 0x96, 0x64, 0xc6, 0xd8, 0xe0, 0x5b, 0x07, 0xfd, 0xff, 0xb9, 0x98, 0x41, 0x01,
-	0xcb, 0xc5, 0xf3, 0x15, 0x64, 0xe2, 0x0b, 0x26, 0x20, 0x84, 0x98, 0x15, 0x09, 0x7e, 0x8a, 0x9a,
-	0x20, 0x29, 0xd7, 0xe9, 0x3b, 0xc3, 0xf6, 0xe8, 0xd8, 0xd3, 0x32, 0x9e, 0x95, 0xf1, 0x9e, 0x65,
-	0x9b, 0xe0, 0xe8, 0xa7, 0x1f, 0xce, 0x0e, 0x2b, 0x0a, 0xa1, 0x9e, 0xc2, 0xc7, 0xa8, 0xb9, 0x62,
-	0x02, 0xb8, 0x5b, 0xeb, 0xd7, 0x87, 0xfb, 0xa1, 0x06, 0xf8, 0x14, 0xed, 0x91, 0x38, 0x86, 0x5c,
-	0x40, 0xe2, 0xd6, 0xfb, 0xce, 0x70, 0x2f, 0xdc, 0xe2, 0x01, 0x45, 0x27, 0x9f, 0x12, 0x01, 0x5c,
-	0x58, 0xbd, 0x60, 0xce, 0xe2, 0x9b, 0x97, 0x40, 0xd3, 0x99, 0xc0, 0xef, 0xa3, 0x07, 0x60, 0xe8,
-	0xe9, 0x4c, 0x51, 0xca, 0x57, 0x23, 0xec, 0x58, 0xda, 0x34, 0xbe, 0x83, 0x0e, 0x4d, 0xc2, 0xa6,
-	0xad, 0xa6, 0xda, 0x0e, 0x34, 0xa9, 0x9b, 0x06, 0x9f, 0xa3, 0x8e, 0xbd, 0x64, 0x4c, 0xd3, 0x0c,
-	0x0a, 0x69, 0x37, 0x67, 0x5f, 0x43, 0x61, 0x54, 0x35, 0xc0, 0x1f, 0xa0, 0x87, 0xdb, 0x5b, 0x49,
-	0x92, 0x14, 0xc0, 0xb9, 0xd2, 0xdb, 0x0f, 0xb7, 0x6e, 0x9e, 0x69, 0x7a, 0xf0, 0x8d, 0x83, 0xda,
-	0x5a, 0x6b, 0x0c, 0x62, 0xb2, 0x96, 0x82, 0x19, 0xcb, 0x62, 0xb0, 0x82, 0x0a, 0xe0, 0x47, 0xa8,
-	0x55, 0xb1, 0x65, 0x10, 0xbe, 0x42, 0xbb, 0x5c, 0x0d, 0x73, 0xb7, 0xde, 0xaf, 0x0f, 0xdb, 0xa3,
-	0x53, 0xef, 0xcd, 0xce, 0x78, 0x55, 0xaf, 0xc1, 0xff, 0xbe, 0xff, 0xb5, 0xf7, 0xa0, 0xca, 0xf1,
-	0xd0, 0xce, 0x0f, 0x7e, 0x74, 0xd0, 0x6e, 0x40, 0x44, 0x3c, 0x9b, 0xac, 0x71, 0x0f, 0xb5, 0x23,
-	0x79, 0x9c, 0x96, 0xad, 0x20, 0x45, 0x7d, 0xa6, 0xfc, 0xb8, 0x68, 0x57, 0x2e, 0x19, 0x5b, 0x5a,
-	0x43, 0x16, 0xe2, 0x8f, 0xd1, 0x81, 0x28, 0x48, 0xc6, 0x49, 0x2c, 0x28, 0xcb, 0xfe, 0xd6, 0xd6,
-	0x18, 0xb2, 0x64, 0xc2, 0xac, 0x91, 0xb0, 0xd2, 0x8f, 0xdf, 0x45, 0x1d, 0xc1, 0x6e, 0x20, 0x9b,
-	0xc6, 0x2c, 0x13, 0x05, 0x89, 0x85, 0xdb, 0x50, 0xc1, 0x1d, 0x2a, 0xf6, 0xd2, 0x90, 0xa5, 0x40,
-	0x9a, 0xe5, 0x40, 0x06, 0xbf, 0x3b, 0xa8, 0x53, 0xd5, 0xc7, 0x1d, 0x54, 0xa3, 0x89, 0x79, 0x86,
-	0x1a, 0x4d, 0xe4, 0x28, 0x87, 0x2c, 0x81, 0xc2, 0xbc, 0x12, 0x83, 0xf0, 0x19, 0xc2, 0xdb, 0x97,
-	0x56, 0x40, 0x4c, 0x73, 0x2a, 0xb7, 0xb8, 0xae, 0x7a, 0x8e, 0x6c, 0x25, 0xb4, 0x05, 0xfc, 0x14,
-	0xb5, 0xa1, 0x88, 0x47, 0xe7, 0x53, 0x65, 0x4c, 0xb9, 0x6c, 0x8f, 0x1e, 0x55, 0xe2, 0x0f, 0x2f,
-	0x47, 0xe7, 0x13, 0x59, 0x0d, 0x1a, 0xaf, 0x6e, 0x7b, 0x3b, 0x21, 0x52, 0x03, 0x8a, 0xc1, 0x1f,
-	0xa1, 0x7d, 0x3d, 0x7e, 0x0d, 0xa0, 0x9e, 0xe1, 0xdf, 0x86, 0xf7, 0x54, 0xfb, 0x0b, 0x80, 0xc1,
-	0x1f, 0x35, 0xd4, 0xb1, 0x41, 0x5c, 0x92, 0xf9, 0x7c, 0xb2, 0x96, 0xde, 0x69, 0xb6, 0x22, 0x73,
-	0x9a, 0x10, 0x19, 0x63, 0xe5, 0xbd, 0x1d, 0x95, 0x2b, 0xfa, 0xf5, 0xa5, 0x6f, 0xb5, 0xf3, 0x98,
-	0xe5, 0xa0, 0xe2, 0x38, 0x08, 0x9e, 0xfc, 0x79, 0xdb, 0x7b, 0x9c, 0x52, 0x31, 0x5b, 0x46, 0x5e,
-	0xcc, 0x16, 0xbe, 0x50, 0xe9, 0x2c, 0x68, 0x26, 0xca, 0xc7, 0x39, 0x8d, 0xb8, 0x1f, 0x6d, 0x04,
-	0x70, 0xef, 0x25, 0xac, 0x03, 0x79, 0xa8, 0x5e, 0x34, 0x96, 0x92, 0x72, 0x4f, 0xec, 0xfe, 0xeb,
-	0x20, 0x2d, 0x94, 0x95, 0x9c, 0x6c, 0xe6, 0x8c, 0x24, 0x2a, 0xba, 0x83, 0xd0, 0xc2, 0xf2, 0x6e,
-	0x35, 0xab, 0xbb, 0xf5, 0x18, 0xb5, 0x54, 0xd8, 0xdc, 0x6d, 0xa9, 0xad, 0xfa, 0xe7, 0xc0, 0x4c,
-	0x2f, 0x3e, 0x47, 0x8d, 0x6b, 0x00, 0xee, 0xee, 0xfe, 0x87, 0x19, 0xd5, 0x59, 0x5a, 0xae, 0xbd,
-	0xca, 0x72, 0xe5, 0x08, 0xbd, 0x99, 0x90, 0xdf, 0xa4, 0xed, 0x8e, 0x3a, 0xea, 0xe1, 0xb6, 0x18,
-	0xbf, 0x40, 0x2d, 0xb2, 0x60, 0xcb, 0x4c, 0xff, 0x7b, 0xec, 0x07, 0x9e, 0x54, 0xff, 0xe5, 0xb6,
-	0xf7, 0x5e, 0x29, 0x58, 0xf3, 0xf9, 0xd5, 0x7f, 0xce, 0x78, 0x72, 0xe3, 0x8b, 0x4d, 0x0e, 0xdc,
-	0xbb, 0xca, 0x44, 0x68, 0xa6, 0x07, 0x27, 0xa8, 0x79, 0xf5, 0xc9, 0x18, 0x04, 0x7e, 0x88, 0xea,
-	0x34, 0xe1, 0xae, 0xd3, 0xaf, 0x0f, 0x1b, 0xa1, 0x3c, 0x06, 0xe1, 0xab, 0xbb, 0xae, 0xf3, 0xfa,
-	0xae, 0xeb, 0xfc, 0x76, 0xd7, 0x75, 0xbe, 0xbb, 0xef, 0xee, 0xbc, 0xbe, 0xef, 0xee, 0xfc, 0x7c,
-	0xdf, 0xdd, 0xf9, 0xf2, 0x49, 0xe9, 0x92, 0x1c, 0xd2, 0x74, 0xf3, 0xd5, 0xca, 0xfe, 0xb0, 0x9c,
-	0x45, 0x05, 0x4d, 0x52, 0xf0, 0x17, 0x2c, 0x59, 0xce, 0xc1, 0x5f, 0x5b, 0x5e, 0x5f, 0x1d, 0xb5,
-	0xd4, 0x47, 0xfa, 0xc3, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x32, 0xe5, 0xf6, 0xc4, 0x93, 0x06,
-	0x00, 0x00,
+func (m *GravitySigningInfo) Reset()         { *m = GravitySigningInfo{} }
+func (m *GravitySigningInfo) String() string { return proto.CompactTextString(m) }
+func (*GravitySigningInfo) ProtoMessage()    {}
+func (*GravitySigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{11}
 }
-
-func (m *EthereumEventVoteRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GravitySigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GravitySigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GravitySigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EthereumEventVoteRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GravitySigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GravitySigningInfo.Merge(m, src)
+}
+func (m *GravitySigningInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *GravitySigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GravitySigningInfo.DiscardUnknown(m)
 }
 
-func (m *EthereumEventVoteRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Accepted {
-		i--
-		if m.Accepted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+var xxx_messageInfo_GravitySigningInfo proto.InternalMessageInfo
+
+func (m *GravitySigningInfo) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if len(m.Votes) > 0 {
-		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Votes[iNdEx])
-			copy(dAtA[i:], m.Votes[iNdEx])
-			i = encodeVarintGravity(dAtA, i, uint64(len(m.Votes[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	return ""
+}
+
+func (m *GravitySigningInfo) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
 	}
-	if m.Event != nil {
-		{
-			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGravity(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *GravitySigningInfo) GetMissedCounter() uint64 {
+	if m != nil {
+		return m.MissedCounter
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *LatestEthereumBlockHeight) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GravitySigningInfo) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *LatestEthereumBlockHeight) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// OutflowRateLimit caps the amount of a denom, fees included, that can be sent
+// to Ethereum over a rolling window of Cosmos blocks
+type OutflowRateLimit struct {
+	Denom     string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	MaxAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=max_amount,json=maxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_amount"`
+	Window    uint64                                 `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
 }
 
-func (m *LatestEthereumBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CosmosHeight != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.CosmosHeight))
-		i--
-		dAtA[i] = 0x10
+func (m *OutflowRateLimit) Reset()         { *m = OutflowRateLimit{} }
+func (m *OutflowRateLimit) String() string { return proto.CompactTextString(m) }
+func (*OutflowRateLimit) ProtoMessage()    {}
+func (*OutflowRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{12}
+}
+func (m *OutflowRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutflowRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutflowRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.EthereumHeight != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.EthereumHeight))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *OutflowRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutflowRateLimit.Merge(m, src)
+}
+func (m *OutflowRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutflowRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutflowRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutflowRateLimit proto.InternalMessageInfo
+
+func (m *OutflowRateLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EthereumSigner) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OutflowRateLimit) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EthereumSigner) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// LargeWithdrawalThreshold is the amount of a denom, fees included, above which
+// a send to Ethereum is held for the large withdrawal delay before it can be
+// batched
+type LargeWithdrawalThreshold struct {
+	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (m *EthereumSigner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EthereumAddress) > 0 {
-		i -= len(m.EthereumAddress)
-		copy(dAtA[i:], m.EthereumAddress)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Power != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x8
+func (m *LargeWithdrawalThreshold) Reset()         { *m = LargeWithdrawalThreshold{} }
+func (m *LargeWithdrawalThreshold) String() string { return proto.CompactTextString(m) }
+func (*LargeWithdrawalThreshold) ProtoMessage()    {}
+func (*LargeWithdrawalThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{13}
+}
+func (m *LargeWithdrawalThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LargeWithdrawalThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LargeWithdrawalThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *LargeWithdrawalThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LargeWithdrawalThreshold.Merge(m, src)
+}
+func (m *LargeWithdrawalThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *LargeWithdrawalThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_LargeWithdrawalThreshold.DiscardUnknown(m)
 }
 
-func (m *SignerSetTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LargeWithdrawalThreshold proto.InternalMessageInfo
+
+func (m *LargeWithdrawalThreshold) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SignerSetTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventVoteThreshold is the fraction of the total voting power that must vote
+// for an Ethereum event of event_type, its protobuf message name, before it is
+// observed
+type EventVoteThreshold struct {
+	EventType string                                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Threshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold"`
 }
 
-func (m *SignerSetTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGravity(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *EventVoteThreshold) Reset()         { *m = EventVoteThreshold{} }
+func (m *EventVoteThreshold) String() string { return proto.CompactTextString(m) }
+func (*EventVoteThreshold) ProtoMessage()    {}
+func (*EventVoteThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{14}
+}
+func (m *EventVoteThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVoteThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVoteThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *EventVoteThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVoteThreshold.Merge(m, src)
+}
+func (m *EventVoteThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVoteThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVoteThreshold.DiscardUnknown(m)
 }
 
-func (m *BatchTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventVoteThreshold proto.InternalMessageInfo
+
+func (m *EventVoteThreshold) GetEventType() string {
+	if m != nil {
+		return m.EventType
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BatchTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MinBatchFee is the total fee, in units of the token, the sends to Ethereum of
+// a batch tx of token_contract must pay before the batch is created
+type MinBatchFee struct {
+	TokenContract string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Amount        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (m *BatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.TokenContract) > 0 {
-		i -= len(m.TokenContract)
-		copy(dAtA[i:], m.TokenContract)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Transactions) > 0 {
-		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGravity(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MinBatchFee) Reset()         { *m = MinBatchFee{} }
+func (m *MinBatchFee) String() string { return proto.CompactTextString(m) }
+func (*MinBatchFee) ProtoMessage()    {}
+func (*MinBatchFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{15}
+}
+func (m *MinBatchFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinBatchFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinBatchFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Timeout != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Timeout))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.BatchNonce != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.BatchNonce))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MinBatchFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinBatchFee.Merge(m, src)
+}
+func (m *MinBatchFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinBatchFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinBatchFee.DiscardUnknown(m)
 }
 
-func (m *SendToEthereum) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MinBatchFee proto.InternalMessageInfo
+
+func (m *MinBatchFee) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SendToEthereum) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TokenTransferGas is the gas a transfer of token_contract costs in a batch tx,
+// for tokens whose transfers cost more than the batch_transfer_gas param
+type TokenTransferGas struct {
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	TransferGas   uint64 `protobuf:"varint,2,opt,name=transfer_gas,json=transferGas,proto3" json:"transfer_gas,omitempty"`
 }
 
-func (m *SendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Erc20Fee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGravity(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.Erc20Token.MarshalToSizedBuffer(dAtA[:i])
+func (m *TokenTransferGas) Reset()         { *m = TokenTransferGas{} }
+func (m *TokenTransferGas) String() string { return proto.CompactTextString(m) }
+func (*TokenTransferGas) ProtoMessage()    {}
+func (*TokenTransferGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{16}
+}
+func (m *TokenTransferGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenTransferGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenTransferGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGravity(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.EthereumRecipient) > 0 {
-		i -= len(m.EthereumRecipient)
-		copy(dAtA[i:], m.EthereumRecipient)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumRecipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Id != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *TokenTransferGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenTransferGas.Merge(m, src)
+}
+func (m *TokenTransferGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenTransferGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenTransferGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenTransferGas proto.InternalMessageInfo
+
+func (m *TokenTransferGas) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ContractCallTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TokenTransferGas) GetTransferGas() uint64 {
+	if m != nil {
+		return m.TransferGas
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ContractCallTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PendingSendToEthereum is a large send to Ethereum held until release_height,
+// until which governance or the withdrawal guardian can cancel it
+type PendingSendToEthereum struct {
+	SendToEthereum SendToEthereum `protobuf:"bytes,1,opt,name=send_to_ethereum,json=sendToEthereum,proto3" json:"send_to_ethereum"`
+	ReleaseHeight  uint64         `protobuf:"varint,2,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty"`
 }
 
-func (m *ContractCallTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x40
+func (m *PendingSendToEthereum) Reset()         { *m = PendingSendToEthereum{} }
+func (m *PendingSendToEthereum) String() string { return proto.CompactTextString(m) }
+func (*PendingSendToEthereum) ProtoMessage()    {}
+func (*PendingSendToEthereum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{17}
+}
+func (m *PendingSendToEthereum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSendToEthereum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSendToEthereum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Fees) > 0 {
-		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGravity(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
+}
+func (m *PendingSendToEthereum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSendToEthereum.Merge(m, src)
+}
+func (m *PendingSendToEthereum) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSendToEthereum) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSendToEthereum.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSendToEthereum proto.InternalMessageInfo
+
+func (m *PendingSendToEthereum) GetSendToEthereum() SendToEthereum {
+	if m != nil {
+		return m.SendToEthereum
+	}
+	return SendToEthereum{}
+}
+
+func (m *PendingSendToEthereum) GetReleaseHeight() uint64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+// BadSignatureEvidenceActivation is recorded when a chain that didn't index the
+// checkpoints of its outgoing txs is upgraded. The outgoing txs deleted before
+// then are unknown, so bad signature evidence is only accepted for signer set
+// txs and batch txs with a higher nonce than the latest ones at that height,
+// and for contract call txs created after that height. The height of a
+// contract call tx isn't part of its checkpoint, so its timeout must also be
+// past the last observed ethereum height at the upgrade, which the contract
+// call txs that timed out before the upgrade are not.
+type BadSignatureEvidenceActivation struct {
+	Height           uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	SignerSetTxNonce uint64 `protobuf:"varint,2,opt,name=signer_set_tx_nonce,json=signerSetTxNonce,proto3" json:"signer_set_tx_nonce,omitempty"`
+	BatchNonce       uint64 `protobuf:"varint,3,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	EthereumHeight   uint64 `protobuf:"varint,4,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+}
+
+func (m *BadSignatureEvidenceActivation) Reset()         { *m = BadSignatureEvidenceActivation{} }
+func (m *BadSignatureEvidenceActivation) String() string { return proto.CompactTextString(m) }
+func (*BadSignatureEvidenceActivation) ProtoMessage()    {}
+func (*BadSignatureEvidenceActivation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{18}
+}
+func (m *BadSignatureEvidenceActivation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BadSignatureEvidenceActivation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BadSignatureEvidenceActivation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Tokens) > 0 {
-		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+}
+func (m *BadSignatureEvidenceActivation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BadSignatureEvidenceActivation.Merge(m, src)
+}
+func (m *BadSignatureEvidenceActivation) XXX_Size() int {
+	return m.Size()
+}
+func (m *BadSignatureEvidenceActivation) XXX_DiscardUnknown() {
+	xxx_messageInfo_BadSignatureEvidenceActivation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BadSignatureEvidenceActivation proto.InternalMessageInfo
+
+func (m *BadSignatureEvidenceActivation) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BadSignatureEvidenceActivation) GetSignerSetTxNonce() uint64 {
+	if m != nil {
+		return m.SignerSetTxNonce
+	}
+	return 0
+}
+
+func (m *BadSignatureEvidenceActivation) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+func (m *BadSignatureEvidenceActivation) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EthereumEventVoteRecord)(nil), "gravity.v1.EthereumEventVoteRecord")
+	proto.RegisterType((*EthereumEventVote)(nil), "gravity.v1.EthereumEventVote")
+	proto.RegisterType((*EthereumEventSummary)(nil), "gravity.v1.EthereumEventSummary")
+	proto.RegisterType((*LatestEthereumBlockHeight)(nil), "gravity.v1.LatestEthereumBlockHeight")
+	proto.RegisterType((*EthereumSigner)(nil), "gravity.v1.EthereumSigner")
+	proto.RegisterType((*SignerSetTx)(nil), "gravity.v1.SignerSetTx")
+	proto.RegisterType((*BatchTx)(nil), "gravity.v1.BatchTx")
+	proto.RegisterType((*SendToEthereum)(nil), "gravity.v1.SendToEthereum")
+	proto.RegisterType((*ContractCallTx)(nil), "gravity.v1.ContractCallTx")
+	proto.RegisterType((*ERC20Token)(nil), "gravity.v1.ERC20Token")
+	proto.RegisterType((*IDSet)(nil), "gravity.v1.IDSet")
+	proto.RegisterType((*GravitySigningInfo)(nil), "gravity.v1.GravitySigningInfo")
+	proto.RegisterType((*OutflowRateLimit)(nil), "gravity.v1.OutflowRateLimit")
+	proto.RegisterType((*LargeWithdrawalThreshold)(nil), "gravity.v1.LargeWithdrawalThreshold")
+	proto.RegisterType((*EventVoteThreshold)(nil), "gravity.v1.EventVoteThreshold")
+	proto.RegisterType((*MinBatchFee)(nil), "gravity.v1.MinBatchFee")
+	proto.RegisterType((*TokenTransferGas)(nil), "gravity.v1.TokenTransferGas")
+	proto.RegisterType((*PendingSendToEthereum)(nil), "gravity.v1.PendingSendToEthereum")
+	proto.RegisterType((*BadSignatureEvidenceActivation)(nil), "gravity.v1.BadSignatureEvidenceActivation")
+}
+
+func init() { proto.RegisterFile("gravity/v1/gravity.proto", fileDescriptor_1715a041eadeb531) }
+
+var fileDescriptor_1715a041eadeb531 = []byte{
+	// 1391 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xfa, 0x4f, 0x12, 0x3f, 0x27, 0xae, 0xb3, 0x0d, 0xc5, 0x89, 0xa8, 0x93, 0xba, 0x2a,
+	0x04, 0x50, 0xec, 0x26, 0x54, 0x82, 0x1e, 0x8a, 0x14, 0xa7, 0x6d, 0x9a, 0x2a, 0xa5, 0xed, 0xc6,
+	0xa2, 0x12, 0x42, 0x5a, 0x8d, 0x77, 0x9f, 0xed, 0x51, 0xbd, 0x3b, 0xd6, 0xce, 0xd8, 0x71, 0x24,
+	0x2e, 0x5c, 0x38, 0x20, 0x21, 0x38, 0xf1, 0x05, 0xb8, 0x20, 0x6e, 0x48, 0x7c, 0x88, 0x8a, 0x53,
+	0x8f, 0x88, 0x43, 0x41, 0xed, 0x85, 0x4f, 0xc0, 0x81, 0x13, 0x9a, 0x3f, 0xeb, 0xec, 0x26, 0x01,
+	0x5a, 0xda, 0x93, 0xe7, 0xfd, 0xe6, 0xbd, 0xdf, 0xbe, 0x79, 0xef, 0xcd, 0x7b, 0x63, 0xa8, 0x74,
+	0x23, 0x32, 0xa2, 0xe2, 0xb0, 0x31, 0xda, 0x68, 0x98, 0x65, 0x7d, 0x10, 0x31, 0xc1, 0x6c, 0x88,
+	0xc5, 0xd1, 0xc6, 0xf2, 0x92, 0xc7, 0x78, 0xc0, 0xb8, 0xab, 0x76, 0x1a, 0x5a, 0xd0, 0x6a, 0xcb,
+	0x2b, 0x5d, 0xc6, 0xba, 0x7d, 0x6c, 0x28, 0xa9, 0x3d, 0xec, 0x34, 0x04, 0x0d, 0x90, 0x0b, 0x12,
+	0x0c, 0x8c, 0xc2, 0x62, 0x97, 0x75, 0x99, 0x36, 0x94, 0x2b, 0x83, 0x56, 0x35, 0x49, 0xa3, 0x4d,
+	0x38, 0x36, 0x46, 0x1b, 0x6d, 0x14, 0x64, 0xa3, 0xe1, 0x31, 0x1a, 0x9a, 0xfd, 0xa5, 0xe3, 0xb4,
+	0x24, 0x34, 0x8e, 0xd5, 0xbe, 0xcd, 0xc2, 0xeb, 0x37, 0x44, 0x0f, 0x23, 0x1c, 0x06, 0x37, 0x46,
+	0x18, 0x8a, 0x8f, 0x99, 0x40, 0x07, 0x3d, 0x16, 0xf9, 0xf6, 0x35, 0xc8, 0xa3, 0x84, 0x2a, 0xd6,
+	0xaa, 0xb5, 0x56, 0xdc, 0x5c, 0xac, 0x6b, 0x9a, 0x7a, 0x4c, 0x53, 0xdf, 0x0a, 0x0f, 0x9b, 0x0b,
+	0x3f, 0xff, 0xb4, 0x3e, 0x9f, 0x62, 0x70, 0xb4, 0x95, 0xbd, 0x0c, 0xb3, 0xc4, 0xf3, 0x70, 0x20,
+	0xd0, 0xaf, 0x64, 0x57, 0xad, 0xb5, 0x59, 0x67, 0x22, 0xdb, 0xe7, 0x60, 0xba, 0x87, 0xb4, 0xdb,
+	0x13, 0x95, 0xdc, 0xaa, 0xb5, 0x96, 0x73, 0x8c, 0x64, 0x5f, 0x85, 0xfc, 0x88, 0x09, 0xe4, 0x95,
+	0xfc, 0x6a, 0x76, 0xad, 0xb8, 0x79, 0xbe, 0x7e, 0x14, 0xb7, 0xfa, 0x09, 0x37, 0x9b, 0xb9, 0x47,
+	0x4f, 0x56, 0xa6, 0x1c, 0x6d, 0x61, 0xdf, 0x01, 0x90, 0x0b, 0x77, 0xc0, 0x0e, 0x30, 0xaa, 0x4c,
+	0xaf, 0x5a, 0x6b, 0x85, 0x66, 0x5d, 0x2a, 0xfc, 0xfa, 0x64, 0xe5, 0xcd, 0x2e, 0x15, 0xbd, 0x61,
+	0xbb, 0xee, 0xb1, 0xc0, 0x04, 0xdc, 0xfc, 0xac, 0x73, 0xff, 0x61, 0x43, 0x1c, 0x0e, 0x90, 0xd7,
+	0x77, 0x43, 0xe1, 0x14, 0x24, 0xc3, 0x3d, 0x49, 0x60, 0xdf, 0x85, 0xa2, 0x60, 0x82, 0xf4, 0x0d,
+	0xdf, 0xcc, 0xff, 0xe2, 0x03, 0x45, 0xa1, 0x09, 0xdf, 0x82, 0x33, 0x5e, 0x84, 0x44, 0x50, 0x16,
+	0xba, 0xe6, 0xec, 0xb3, 0xea, 0xec, 0xa5, 0x18, 0xbe, 0xa5, 0xd0, 0xdb, 0xb9, 0xd9, 0x4c, 0x39,
+	0x5b, 0xdb, 0x81, 0x85, 0x13, 0x07, 0xb6, 0xdf, 0x80, 0xc2, 0x88, 0xf4, 0xa9, 0x4f, 0x04, 0x8b,
+	0x54, 0x56, 0x0a, 0xce, 0x11, 0x60, 0x2f, 0x42, 0x5e, 0x3b, 0x9b, 0x59, 0xb5, 0xd6, 0xb2, 0x8e,
+	0x16, 0x6a, 0xdf, 0x5b, 0xb0, 0x98, 0x62, 0xda, 0x1f, 0x06, 0x01, 0x89, 0x0e, 0xed, 0x15, 0x28,
+	0xaa, 0x44, 0xb9, 0x21, 0x0b, 0x3d, 0x54, 0x74, 0x39, 0x07, 0x14, 0xf4, 0x91, 0x44, 0xec, 0x07,
+	0xa0, 0x25, 0xb7, 0x47, 0x78, 0x4f, 0x91, 0xce, 0x35, 0x3f, 0xf8, 0xeb, 0xc9, 0xca, 0x95, 0xc4,
+	0xe9, 0x05, 0x86, 0x3e, 0x46, 0x01, 0x0d, 0x45, 0x72, 0xd9, 0xa7, 0x6d, 0xde, 0x68, 0x1f, 0x0a,
+	0xe4, 0xf5, 0x5b, 0x38, 0x6e, 0xca, 0x85, 0x53, 0x50, 0x5c, 0xb7, 0x08, 0xef, 0x25, 0xb2, 0x9f,
+	0x4d, 0x66, 0xbf, 0x46, 0x61, 0x69, 0x8f, 0x08, 0xe4, 0x22, 0xf6, 0xb7, 0xd9, 0x67, 0xde, 0x43,
+	0x1d, 0x16, 0x19, 0x3f, 0x34, 0x70, 0x1c, 0x3f, 0xed, 0x72, 0x29, 0x86, 0x8d, 0xe2, 0x45, 0x98,
+	0x37, 0x37, 0xcc, 0xa8, 0x65, 0x94, 0xda, 0x9c, 0x06, 0xb5, 0x52, 0xed, 0x3e, 0x94, 0xe2, 0x8f,
+	0xec, 0xd3, 0x6e, 0x88, 0x89, 0xe8, 0x69, 0x56, 0x2d, 0xd8, 0x6f, 0x43, 0x79, 0xf2, 0x55, 0xe2,
+	0xfb, 0x11, 0x72, 0xae, 0xf8, 0x0a, 0xce, 0xc4, 0x9b, 0x2d, 0x0d, 0xd7, 0xbe, 0xb0, 0xa0, 0xa8,
+	0xb9, 0xf6, 0x51, 0xb4, 0xc6, 0x92, 0x30, 0x19, 0x59, 0x2d, 0x24, 0xce, 0x9e, 0x49, 0x55, 0xfe,
+	0x2e, 0xcc, 0x70, 0x65, 0xcc, 0x2b, 0x59, 0x55, 0xfb, 0xcb, 0xa7, 0xd5, 0xbe, 0xe6, 0x6f, 0x9e,
+	0xfd, 0xe1, 0xb7, 0x95, 0x33, 0x69, 0x8c, 0x3b, 0xb1, 0x7d, 0xed, 0x0f, 0x0b, 0x66, 0x9a, 0x44,
+	0x78, 0xbd, 0xd6, 0x58, 0x26, 0xb9, 0x2d, 0x97, 0xe9, 0x24, 0x2b, 0x48, 0x27, 0xb9, 0x02, 0x33,
+	0xb2, 0xc9, 0xb0, 0x61, 0xec, 0x50, 0x2c, 0xda, 0x1f, 0xc2, 0x9c, 0x88, 0x48, 0xc8, 0x89, 0x27,
+	0x8b, 0xf3, 0x54, 0xb7, 0xf6, 0x31, 0xf4, 0x5b, 0x2c, 0x76, 0xc4, 0x49, 0xe9, 0xdb, 0x97, 0xa0,
+	0x24, 0xd8, 0x43, 0x0c, 0x5d, 0x8f, 0x85, 0x22, 0x22, 0x9e, 0xbe, 0xeb, 0x05, 0x67, 0x5e, 0xa1,
+	0xdb, 0x06, 0x4c, 0x04, 0x24, 0x9f, 0x0a, 0xc8, 0x45, 0x98, 0x47, 0x2e, 0x68, 0x40, 0x04, 0xfa,
+	0x6e, 0x97, 0x70, 0x75, 0xa5, 0x73, 0xce, 0xdc, 0x04, 0xdc, 0x21, 0xbc, 0xf6, 0x55, 0x06, 0x4a,
+	0x69, 0x27, 0xec, 0x12, 0x64, 0xa8, 0x6f, 0x0e, 0x9a, 0xa1, 0xaa, 0xd5, 0x70, 0x55, 0x99, 0x26,
+	0x6f, 0x46, 0xb2, 0xd7, 0xc1, 0x9e, 0x64, 0x36, 0x42, 0x8f, 0x0e, 0x28, 0x86, 0xba, 0x20, 0x0b,
+	0xce, 0x42, 0xbc, 0xe3, 0xc4, 0x1b, 0xf6, 0x35, 0x28, 0x62, 0xe4, 0x6d, 0x5e, 0x76, 0x95, 0xf7,
+	0xea, 0x28, 0xc5, 0xcd, 0x73, 0xa9, 0x1c, 0x39, 0xdb, 0x9b, 0x97, 0x5b, 0x72, 0xd7, 0x34, 0x26,
+	0x50, 0x06, 0x0a, 0xb1, 0xaf, 0x42, 0x41, 0x9b, 0x77, 0x10, 0x2b, 0xf9, 0xe7, 0x30, 0x9e, 0x55,
+	0xea, 0x37, 0x11, 0xed, 0x77, 0x60, 0x01, 0xc7, 0x03, 0x1a, 0x21, 0x77, 0x89, 0x88, 0x6b, 0x5a,
+	0x07, 0xe3, 0x8c, 0xd9, 0xd8, 0x12, 0xa6, 0xac, 0xff, 0xcc, 0x40, 0x29, 0x8e, 0xec, 0x36, 0xe9,
+	0xf7, 0x5b, 0x63, 0x79, 0x4e, 0x1a, 0x9a, 0x26, 0x21, 0x7b, 0x4f, 0xb2, 0x10, 0x16, 0x92, 0x3b,
+	0xba, 0x1e, 0xba, 0xc7, 0xd4, 0xb9, 0xc7, 0x06, 0xf8, 0xd2, 0x97, 0x3f, 0xf5, 0xa1, 0x7d, 0x49,
+	0x29, 0x0b, 0x2f, 0xbe, 0x50, 0x3a, 0xe8, 0xb1, 0x28, 0x77, 0x06, 0xe4, 0xb0, 0xcf, 0x88, 0xaf,
+	0xc2, 0x3c, 0xe7, 0xc4, 0x62, 0xb2, 0x58, 0xf3, 0xe9, 0x62, 0xbd, 0x02, 0xd3, 0x2a, 0x31, 0xb2,
+	0x4c, 0xb2, 0xff, 0x19, 0x5c, 0xa3, 0x6b, 0x5f, 0x86, 0x5c, 0x07, 0x91, 0x57, 0x66, 0x9e, 0xc3,
+	0x46, 0x69, 0x26, 0xaa, 0x75, 0x36, 0xd5, 0xba, 0x06, 0x00, 0x47, 0x16, 0x72, 0xf4, 0x4d, 0x8a,
+	0x5e, 0xb7, 0xe9, 0x89, 0x6c, 0xdf, 0x84, 0x69, 0x12, 0xb0, 0x61, 0xa8, 0xef, 0xdb, 0x8b, 0xcf,
+	0x14, 0x63, 0x5d, 0x5b, 0x82, 0xfc, 0xee, 0xf5, 0x7d, 0x14, 0x76, 0x19, 0xb2, 0xd4, 0xe7, 0x15,
+	0x6b, 0x35, 0xbb, 0x96, 0x73, 0xe4, 0xb2, 0xf6, 0x9d, 0x05, 0xf6, 0x8e, 0x3e, 0x8a, 0x6c, 0x0e,
+	0x34, 0xec, 0xee, 0x86, 0x1d, 0x66, 0xbf, 0x0b, 0x0b, 0x93, 0x61, 0x31, 0x69, 0x66, 0xda, 0xbd,
+	0xf2, 0x64, 0xc3, 0x74, 0x33, 0xfb, 0x02, 0xcc, 0xd1, 0xd0, 0xc7, 0xb1, 0xcb, 0x3a, 0x1d, 0x8e,
+	0x71, 0x73, 0x28, 0x2a, 0xec, 0xae, 0x82, 0xe4, 0x05, 0x0f, 0x28, 0xe7, 0xe8, 0xbb, 0x9e, 0xf4,
+	0x08, 0x23, 0xd3, 0xce, 0xe7, 0x35, 0xba, 0xad, 0x41, 0x19, 0xb2, 0x03, 0x1a, 0xfa, 0xec, 0x20,
+	0x9e, 0xf5, 0x5a, 0xaa, 0x7d, 0x6d, 0x41, 0xf9, 0xee, 0x50, 0x74, 0xfa, 0xec, 0xc0, 0x21, 0x02,
+	0xf7, 0x68, 0x40, 0x85, 0x6c, 0x9a, 0x3e, 0x86, 0x2c, 0x30, 0x7e, 0x69, 0x41, 0xce, 0xf6, 0x80,
+	0x8c, 0xdd, 0x97, 0x8a, 0x5b, 0x21, 0x20, 0xe3, 0x2d, 0x45, 0x90, 0xf0, 0x28, 0x9b, 0xf2, 0x68,
+	0x0c, 0x95, 0x3d, 0x12, 0x75, 0xf1, 0x01, 0x15, 0x3d, 0x3f, 0x22, 0x07, 0xa4, 0xdf, 0xea, 0x45,
+	0xc8, 0x7b, 0xac, 0xef, 0xff, 0x83, 0x63, 0xaf, 0x2a, 0x99, 0x9f, 0x5b, 0x60, 0x4f, 0xc6, 0xfc,
+	0xd1, 0x47, 0xcf, 0xc7, 0x13, 0x58, 0x5a, 0xc4, 0x03, 0x5f, 0x21, 0xad, 0xc3, 0x01, 0xda, 0x7b,
+	0x50, 0x10, 0xb1, 0xae, 0xb9, 0xa2, 0x2f, 0xe2, 0xc0, 0x75, 0xf4, 0x9c, 0x23, 0x82, 0xda, 0x67,
+	0x50, 0xbc, 0x43, 0x43, 0x35, 0x38, 0x64, 0xdb, 0x39, 0xd9, 0xbe, 0xad, 0xd3, 0xda, 0xf7, 0xab,
+	0x8a, 0xc0, 0xa7, 0x50, 0x56, 0x77, 0xa7, 0x25, 0x47, 0x48, 0x07, 0xa3, 0x1d, 0xc2, 0x9f, 0xd7,
+	0x85, 0x0b, 0x66, 0x50, 0x75, 0x30, 0x52, 0x83, 0xc2, 0x94, 0xaa, 0x38, 0x62, 0xaa, 0x7d, 0x69,
+	0xc1, 0x6b, 0xf7, 0x30, 0xf4, 0x69, 0xd8, 0x3d, 0x36, 0x2e, 0x6e, 0x43, 0x59, 0x0e, 0x04, 0x57,
+	0x30, 0x37, 0x6e, 0xfa, 0xe6, 0xbd, 0xfb, 0x2f, 0x93, 0xce, 0xb4, 0x84, 0x12, 0x4f, 0x73, 0x5d,
+	0x82, 0x52, 0x84, 0x7d, 0x24, 0x1c, 0xd3, 0x4f, 0x8f, 0x79, 0x83, 0x9a, 0x26, 0xfd, 0xa3, 0x05,
+	0xd5, 0x26, 0xf1, 0xe5, 0xd5, 0x24, 0x62, 0x18, 0xe1, 0x8d, 0x11, 0xf5, 0x31, 0xf4, 0x70, 0xcb,
+	0x13, 0x74, 0xa4, 0x3a, 0x64, 0xa2, 0xcd, 0x58, 0xa9, 0xa1, 0xb8, 0x0e, 0x67, 0xf5, 0x94, 0x77,
+	0x39, 0x0a, 0x57, 0x8c, 0x4d, 0x37, 0xd7, 0x9f, 0x29, 0xf3, 0xa3, 0xd7, 0x87, 0x6e, 0xe6, 0xc7,
+	0xa6, 0x7f, 0xf6, 0xc4, 0xf4, 0x3f, 0xe5, 0x51, 0x95, 0x3b, 0xed, 0x51, 0xd5, 0xbc, 0xff, 0xe8,
+	0x69, 0xd5, 0x7a, 0xfc, 0xb4, 0x6a, 0xfd, 0xfe, 0xb4, 0x6a, 0x7d, 0xf3, 0xac, 0x3a, 0xf5, 0xf8,
+	0x59, 0x75, 0xea, 0x97, 0x67, 0xd5, 0xa9, 0x4f, 0xde, 0x3f, 0x99, 0x68, 0x13, 0xb7, 0xf5, 0x76,
+	0x44, 0xfd, 0x2e, 0x36, 0x02, 0xe6, 0x0f, 0xfb, 0xd8, 0x18, 0xc7, 0xb8, 0xce, 0x7e, 0x7b, 0x5a,
+	0xfd, 0x8f, 0x78, 0xef, 0xef, 0x01, 0x00, 0xd9, 0x94, 0xbe, 0x31, 0x36, 0x0d, 0x00, 0x00,
+}
+
+func (m *EthereumEventVoteRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumEventVoteRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumEventVoteRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.TotalPower.Size()
+		i -= size
+		if _, err := m.TotalPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.VotePower.Size()
+		i -= size
+		if _, err := m.VotePower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1024,44 +1402,40 @@ func (m *ContractCallTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGravity(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x2a
 		}
 	}
-	if m.Timeout != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Timeout))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Payload)))
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Address)))
+	if m.Accepted {
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.InvalidationScope) > 0 {
-		i -= len(m.InvalidationScope)
-		copy(dAtA[i:], m.InvalidationScope)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.InvalidationScope)))
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if m.InvalidationNonce != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.InvalidationNonce))
+	if m.Event != nil {
+		{
+			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGravity(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ERC20Token) Marshal() (dAtA []byte, err error) {
+func (m *EthereumEventVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1071,37 +1445,32 @@ func (m *ERC20Token) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ERC20Token) MarshalTo(dAtA []byte) (int, error) {
+func (m *EthereumEventVote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ERC20Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EthereumEventVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGravity(dAtA, i, uint64(size))
+	if m.Power != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Contract)))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Validator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *IDSet) Marshal() (dAtA []byte, err error) {
+func (m *EthereumEventSummary) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1111,285 +1480,2287 @@ func (m *IDSet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IDSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *EthereumEventSummary) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IDSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EthereumEventSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Ids) > 0 {
-		dAtA5 := make([]byte, len(m.Ids)*10)
-		var j4 int
-		for _, num := range m.Ids {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
-		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintGravity(dAtA, i, uint64(j4))
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x18
+	}
+	if len(m.EventHash) > 0 {
+		i -= len(m.EventHash)
+		copy(dAtA[i:], m.EventHash)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.EventHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGravity(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGravity(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *LatestEthereumBlockHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EthereumEventVoteRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *LatestEthereumBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LatestEthereumBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Event != nil {
-		l = m.Event.Size()
-		n += 1 + l + sovGravity(uint64(l))
+	if m.CosmosHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.CosmosHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Votes) > 0 {
-		for _, s := range m.Votes {
-			l = len(s)
-			n += 1 + l + sovGravity(uint64(l))
-		}
-	}
-	if m.Accepted {
-		n += 2
+	if m.EthereumHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LatestEthereumBlockHeight) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EthereumHeight != 0 {
-		n += 1 + sovGravity(uint64(m.EthereumHeight))
-	}
-	if m.CosmosHeight != 0 {
-		n += 1 + sovGravity(uint64(m.CosmosHeight))
+func (m *EthereumSigner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EthereumSigner) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EthereumSigner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumSigner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Power != 0 {
-		n += 1 + sovGravity(uint64(m.Power))
+	if len(m.EthereumAddress) > 0 {
+		i -= len(m.EthereumAddress)
+		copy(dAtA[i:], m.EthereumAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.EthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	if m.Power != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SignerSetTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SignerSetTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovGravity(uint64(m.Nonce))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if m.Height != 0 {
-		n += 1 + sovGravity(uint64(m.Height))
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Signers) > 0 {
-		for _, e := range m.Signers {
-			l = e.Size()
-			n += 1 + l + sovGravity(uint64(l))
-		}
+	if m.Nonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BatchTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BatchNonce != 0 {
-		n += 1 + sovGravity(uint64(m.BatchNonce))
+	if m.EstimatedGas != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EstimatedGas))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Timeout != 0 {
-		n += 1 + sovGravity(uint64(m.Timeout))
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if len(m.Transactions) > 0 {
-		for _, e := range m.Transactions {
-			l = e.Size()
-			n += 1 + l + sovGravity(uint64(l))
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	if m.Timeout != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Height != 0 {
-		n += 1 + sovGravity(uint64(m.Height))
+	if m.BatchNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SendToEthereum) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovGravity(uint64(m.Id))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
-	}
-	l = len(m.EthereumRecipient)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+func (m *SendToEthereum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Erc20Token.Size()
-	n += 1 + l + sovGravity(uint64(l))
-	l = m.Erc20Fee.Size()
-	n += 1 + l + sovGravity(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ContractCallTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SendToEthereum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.InvalidationNonce != 0 {
-		n += 1 + sovGravity(uint64(m.InvalidationNonce))
-	}
-	l = len(m.InvalidationScope)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
-	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
-	}
-	if m.Timeout != 0 {
-		n += 1 + sovGravity(uint64(m.Timeout))
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x30
 	}
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovGravity(uint64(l))
+	{
+		size, err := m.Erc20Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
 	}
-	if len(m.Fees) > 0 {
-		for _, e := range m.Fees {
-			l = e.Size()
-			n += 1 + l + sovGravity(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Erc20Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
 	}
-	if m.Height != 0 {
-		n += 1 + sovGravity(uint64(m.Height))
-	}
-	return n
+	i--
+	dAtA[i] = 0x22
+	if len(m.EthereumRecipient) > 0 {
+		i -= len(m.EthereumRecipient)
+		copy(dAtA[i:], m.EthereumRecipient)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ERC20Token) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractCallTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovGravity(uint64(l))
-	return n
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *IDSet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ERC20Token) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20Token) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IDSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *IDSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IDSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Ids) > 0 {
-		l = 0
-		for _, e := range m.Ids {
-			l += sovGravity(uint64(e))
+		dAtA5 := make([]byte, len(m.Ids)*10)
+		var j4 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		n += 1 + sovGravity(uint64(l)) + l
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintGravity(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovGravity(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *GravitySigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozGravity(x uint64) (n int) {
-	return sovGravity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *GravitySigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGravity
+
+func (m *GravitySigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MissedCounter != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.MissedCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutflowRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutflowRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutflowRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LargeWithdrawalThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LargeWithdrawalThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LargeWithdrawalThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVoteThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVoteThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVoteThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.EventType) > 0 {
+		i -= len(m.EventType)
+		copy(dAtA[i:], m.EventType)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.EventType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MinBatchFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinBatchFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinBatchFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenTransferGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenTransferGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenTransferGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TransferGas != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.TransferGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingSendToEthereum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSendToEthereum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.SendToEthereum.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BadSignatureEvidenceActivation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BadSignatureEvidenceActivation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BadSignatureEvidenceActivation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthereumHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignerSetTxNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.SignerSetTxNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGravity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGravity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EthereumEventVoteRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Accepted {
+		n += 2
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	l = m.VotePower.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	l = m.TotalPower.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	if m.CreationHeight != 0 {
+		n += 1 + sovGravity(uint64(m.CreationHeight))
+	}
+	return n
+}
+
+func (m *EthereumEventVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovGravity(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *EthereumEventSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovGravity(uint64(m.EventNonce))
+	}
+	l = len(m.EventHash)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *LatestEthereumBlockHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthereumHeight != 0 {
+		n += 1 + sovGravity(uint64(m.EthereumHeight))
+	}
+	if m.CosmosHeight != 0 {
+		n += 1 + sovGravity(uint64(m.CosmosHeight))
+	}
+	return n
+}
+
+func (m *EthereumSigner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Power != 0 {
+		n += 1 + sovGravity(uint64(m.Power))
+	}
+	l = len(m.EthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovGravity(uint64(m.Nonce))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	if len(m.Signers) > 0 {
+		for _, e := range m.Signers {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		n += 1 + sovGravity(uint64(m.BatchNonce))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovGravity(uint64(m.Timeout))
+	}
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	if m.EstimatedGas != 0 {
+		n += 1 + sovGravity(uint64(m.EstimatedGas))
+	}
+	return n
+}
+
+func (m *SendToEthereum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGravity(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.EthereumRecipient)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.Erc20Token.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	l = m.Erc20Fee.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovGravity(uint64(m.ExpiresAtHeight))
+	}
+	return n
+}
+
+func (m *ContractCallTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovGravity(uint64(m.InvalidationNonce))
+	}
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovGravity(uint64(m.Timeout))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ERC20Token) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *IDSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovGravity(uint64(e))
+		}
+		n += 1 + sovGravity(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *GravitySigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovGravity(uint64(m.IndexOffset))
+	}
+	if m.MissedCounter != 0 {
+		n += 1 + sovGravity(uint64(m.MissedCounter))
+	}
+	if m.Window != 0 {
+		n += 1 + sovGravity(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *OutflowRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	if m.Window != 0 {
+		n += 1 + sovGravity(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *LargeWithdrawalThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *EventVoteThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EventType)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *MinBatchFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *TokenTransferGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.TransferGas != 0 {
+		n += 1 + sovGravity(uint64(m.TransferGas))
+	}
+	return n
+}
+
+func (m *PendingSendToEthereum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SendToEthereum.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovGravity(uint64(m.ReleaseHeight))
+	}
+	return n
+}
+
+func (m *BadSignatureEvidenceActivation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	if m.SignerSetTxNonce != 0 {
+		n += 1 + sovGravity(uint64(m.SignerSetTxNonce))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovGravity(uint64(m.BatchNonce))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovGravity(uint64(m.EthereumHeight))
+	}
+	return n
+}
+
+func sovGravity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGravity(x uint64) (n int) {
+	return sovGravity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumEventVoteRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumEventVoteRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &types.Any{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, EthereumEventVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotePower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumEventVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumEventVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumEventVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumEventSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumEventSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumEventSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventHash = append(m.EventHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EventHash == nil {
+				m.EventHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestEthereumBlockHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			}
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosHeight", wireType)
+			}
+			m.CosmosHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumSigner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumSigner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumSigner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, &EthereumSigner{})
+			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &SendToEthereum{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGas", wireType)
+			}
+			m.EstimatedGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendToEthereum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EthereumEventVoteRecord: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthereumEventVoteRecord: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Token", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1416,18 +3787,15 @@ func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Event == nil {
-				m.Event = &types.Any{}
-			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Erc20Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1437,29 +3805,30 @@ func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, string(dAtA[iNdEx:postIndex]))
+			if err := m.Erc20Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
 			}
-			var v int
+			m.ExpiresAtHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1469,12 +3838,11 @@ func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ExpiresAtHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Accepted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGravity(dAtA[iNdEx:])
@@ -1499,7 +3867,7 @@ func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
+func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1522,17 +3890,17 @@ func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LatestEthereumBlockHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractCallTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LatestEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractCallTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
 			}
-			m.EthereumHeight = 0
+			m.InvalidationNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1542,16 +3910,203 @@ func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumHeight |= uint64(b&0x7F) << shift
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationScope == nil {
+				m.InvalidationScope = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, ERC20Token{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, ERC20Token{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.CosmosHeight = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1561,7 +4116,7 @@ func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CosmosHeight |= uint64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1590,7 +4145,7 @@ func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EthereumSigner) Unmarshal(dAtA []byte) error {
+func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1613,17 +4168,17 @@ func (m *EthereumSigner) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EthereumSigner: wiretype end group for non-group")
+			return fmt.Errorf("proto: ERC20Token: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthereumSigner: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ERC20Token: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			m.Power = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1633,14 +4188,27 @@ func (m *EthereumSigner) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1668,7 +4236,9 @@ func (m *EthereumSigner) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1694,7 +4264,7 @@ func (m *EthereumSigner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
+func (m *IDSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1717,84 +4287,88 @@ func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignerSetTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: IDSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignerSetTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IDSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGravity
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthGravity
 				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGravity
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGravity
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGravity
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGravity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGravity
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGravity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signers = append(m.Signers, &EthereumSigner{})
-			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGravity(dAtA[iNdEx:])
@@ -1819,7 +4393,7 @@ func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchTx) Unmarshal(dAtA []byte) error {
+func (m *GravitySigningInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1842,17 +4416,17 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: GravitySigningInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GravitySigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.BatchNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1862,16 +4436,29 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
 			}
-			m.Timeout = 0
+			m.IndexOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1881,50 +4468,16 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= uint64(b&0x7F) << shift
+				m.IndexOffset |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGravity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGravity
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGravity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Transactions = append(m.Transactions, &SendToEthereum{})
-			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedCounter", wireType)
 			}
-			var stringLen uint64
+			m.MissedCounter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1933,30 +4486,17 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGravity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGravity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				iNdEx++
+				m.MissedCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			m.Height = 0
+			m.Window = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1966,7 +4506,7 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.Window |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1995,7 +4535,7 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
+func (m *OutflowRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2018,17 +4558,17 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendToEthereum: wiretype end group for non-group")
+			return fmt.Errorf("proto: OutflowRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OutflowRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2038,14 +4578,27 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2073,13 +4626,15 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumRecipient", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			var stringLen uint64
+			m.Window = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2089,29 +4644,69 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Window |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGravity
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumRecipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LargeWithdrawalThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LargeWithdrawalThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LargeWithdrawalThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2121,30 +4716,29 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Erc20Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2154,22 +4748,23 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Erc20Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2197,7 +4792,7 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
+func (m *EventVoteThreshold) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2220,17 +4815,17 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCallTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventVoteThreshold: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCallTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventVoteThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
 			}
-			m.InvalidationNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2240,14 +4835,27 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2274,48 +4882,68 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
-			if m.InvalidationScope == nil {
-				m.InvalidationScope = []byte{}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGravity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGravity
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinBatchFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinBatchFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinBatchFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2325,50 +4953,29 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
-			}
-			m.Timeout = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGravity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timeout |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2378,31 +4985,84 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, ERC20Token{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenTransferGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenTransferGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenTransferGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2412,31 +5072,29 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fees = append(m.Fees, ERC20Token{})
-			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferGas", wireType)
 			}
-			m.Height = 0
+			m.TransferGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2446,7 +5104,7 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.TransferGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2475,7 +5133,7 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20Token) Unmarshal(dAtA []byte) error {
+func (m *PendingSendToEthereum) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2498,17 +5156,17 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20Token: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingSendToEthereum: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20Token: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingSendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendToEthereum", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2518,29 +5176,30 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			if err := m.SendToEthereum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
 			}
-			var stringLen uint64
+			m.ReleaseHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2550,26 +5209,11 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReleaseHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGravity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGravity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGravity(dAtA[iNdEx:])
@@ -2594,7 +5238,7 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IDSet) Unmarshal(dAtA []byte) error {
+func (m *BadSignatureEvidenceActivation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2617,87 +5261,87 @@ func (m *IDSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IDSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: BadSignatureEvidenceActivation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IDSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BadSignatureEvidenceActivation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGravity
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
 				}
-				m.Ids = append(m.Ids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGravity
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGravity
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGravity
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetTxNonce", wireType)
+			}
+			m.SignerSetTxNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetTxNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ids) == 0 {
-					m.Ids = make([]uint64, 0, elementCount)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGravity
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ids = append(m.Ids, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			}
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
 		default:
 			iNdEx = preIndex
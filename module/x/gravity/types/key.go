@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -59,6 +60,51 @@ const (
 	LastUnBondingBlockHeightKey
 
 	LastObservedSignerSetKey
+
+	// LastSlashedEventNonceKey indexes the nonce of the last event vote record checked for slashing
+	LastSlashedEventNonceKey
+
+	// PastEthereumSignatureCheckpointKey indexes the checkpoints of every outgoing tx produced by the chain
+	PastEthereumSignatureCheckpointKey
+
+	// LastSlashedContractCallTxBlockKey indexes the height of the latest contract call tx checked for slashing
+	LastSlashedContractCallTxBlockKey
+
+	// GravitySigningInfoKey indexes the missed signature counters by validator
+	GravitySigningInfoKey
+
+	// MissedSignatureBitArrayKey prefixes the missed signature bit array of each validator
+	MissedSignatureBitArrayKey
+
+	// BridgeHaltedKey indexes the nonce of the executed signer set that halted the bridge
+	BridgeHaltedKey
+
+	// BridgePausedKey is set while the bridge is paused for every token
+	BridgePausedKey
+
+	// PausedTokenKey prefixes the ERC20 contracts the bridge is paused for
+	PausedTokenKey
+
+	// ParkedSendToCosmosEventKey prefixes the deposits of paused tokens waiting to be processed, by event nonce
+	ParkedSendToCosmosEventKey
+
+	// OutflowBucketKey prefixes the amounts sent to ethereum per denom and bucket of blocks
+	OutflowBucketKey
+
+	// PendingSendToEthereumKey prefixes the held large sends to ethereum by release height and id
+	PendingSendToEthereumKey
+
+	// EthereumEventSummaryKey prefixes the summaries of the pruned event vote records by event nonce
+	EthereumEventSummaryKey
+
+	// ERC20EscrowKey prefixes the amounts of the ethereum originated ERC20s held by the bridge contract
+	ERC20EscrowKey
+
+	// SendToEthereumExpiryKey prefixes the fees of the unbatched sends to ethereum that expire, by expiry height and id
+	SendToEthereumExpiryKey
+
+	// BadSignatureEvidenceActivationKey indexes the outgoing tx nonces bad signature evidence is accepted after
+	BadSignatureEvidenceActivationKey
 )
 
 ////////////////////
@@ -90,11 +136,18 @@ func MakeEthereumOrchestratorAddressKey(eth common.Address) []byte {
 // Etheruem Signatures //
 /////////////////////////
 
+// MakeEthereumSignaturePrefix returns the following key format
+// prefix length  store-index
+// [0x0][0x9][0x0 0 0 0 0 0 0 0 1]
+func MakeEthereumSignaturePrefix(storeIndex []byte) []byte {
+	return append([]byte{EthereumSignatureKey}, address.MustLengthPrefix(storeIndex)...)
+}
+
 // MakeEthereumSignatureKey returns the following key format
-// prefix   nonce                    validator-address
-// [0x0][0 0 0 0 0 0 0 1][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+// prefix length  store-index             validator-address
+// [0x0][0x9][0x0 0 0 0 0 0 0 0 1][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn]
 func MakeEthereumSignatureKey(storeIndex []byte, validator sdk.ValAddress) []byte {
-	return bytes.Join([][]byte{{EthereumSignatureKey}, storeIndex, validator.Bytes()}, []byte{})
+	return append(MakeEthereumSignaturePrefix(storeIndex), validator.Bytes()...)
 }
 
 /////////////////////////////////
@@ -108,6 +161,13 @@ func MakeEthereumEventVoteRecordKey(eventNonce uint64, claimHash []byte) []byte
 	return bytes.Join([][]byte{{EthereumEventVoteRecordKey}, sdk.Uint64ToBigEndian(eventNonce), claimHash}, []byte{})
 }
 
+// MakeEthereumEventSummaryKey returns the following key format
+// prefix     nonce
+// [0x1f][0 0 0 0 0 0 0 1]
+func MakeEthereumEventSummaryKey(eventNonce uint64) []byte {
+	return append([]byte{EthereumEventSummaryKey}, sdk.Uint64ToBigEndian(eventNonce)...)
+}
+
 //////////////////
 // Outgoing Txs //
 //////////////////
@@ -117,6 +177,13 @@ func MakeOutgoingTxKey(storeIndex []byte) []byte {
 	return append([]byte{OutgoingTxKey}, storeIndex...)
 }
 
+// MakePastEthereumSignatureCheckpointKey returns the following key format
+// prefix    checkpoint
+// [0x15][fd1af8cec6c67fcf156f1b61fdf91ebc04d05484d007436e75342fc05bbff35a]
+func MakePastEthereumSignatureCheckpointKey(checkpoint []byte) []byte {
+	return append([]byte{PastEthereumSignatureCheckpointKey}, checkpoint...)
+}
+
 //////////////////////
 // Send To Etheruem //
 //////////////////////
@@ -129,6 +196,27 @@ func MakeSendToEthereumKey(id uint64, fee ERC20Token) []byte {
 	return bytes.Join([][]byte{{SendToEthereumKey}, common.HexToAddress(fee.Contract).Bytes(), fee.Amount.BigInt().FillBytes(amount), sdk.Uint64ToBigEndian(id)}, []byte{})
 }
 
+// MakeGravitySigningInfoKey returns the following key format
+// prefix              cosmos-validator
+// [0x17][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeGravitySigningInfoKey(validator sdk.ValAddress) []byte {
+	return append([]byte{GravitySigningInfoKey}, validator.Bytes()...)
+}
+
+// MakeMissedSignatureBitArrayPrefix returns the following key format
+// prefix   length          cosmos-validator
+// [0x18][0x14][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeMissedSignatureBitArrayPrefix(validator sdk.ValAddress) []byte {
+	return append([]byte{MissedSignatureBitArrayKey}, address.MustLengthPrefix(validator.Bytes())...)
+}
+
+// MakeMissedSignatureBitArrayKey returns the following key format
+// prefix   length          cosmos-validator                           index
+// [0x18][0x14][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn][0 0 0 0 0 0 0 1]
+func MakeMissedSignatureBitArrayKey(validator sdk.ValAddress, index uint64) []byte {
+	return append(MakeMissedSignatureBitArrayPrefix(validator), sdk.Uint64ToBigEndian(index)...)
+}
+
 // MakeLastEventNonceByValidatorKey indexes lateset event nonce by validator
 // MakeLastEventNonceByValidatorKey returns the following key format
 // prefix              cosmos-validator
@@ -156,3 +244,52 @@ func MakeBatchTxKey(addr common.Address, nonce uint64) []byte {
 func MakeContractCallTxKey(invalscope []byte, invalnonce uint64) []byte {
 	return bytes.Join([][]byte{{ContractCallTxPrefixByte}, invalscope, sdk.Uint64ToBigEndian(invalnonce)}, []byte{})
 }
+
+// MakePausedTokenKey returns the following key format
+// prefix   eth-contract-address
+// [0x1b][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakePausedTokenKey(contract common.Address) []byte {
+	return append([]byte{PausedTokenKey}, contract.Bytes()...)
+}
+
+// MakeParkedSendToCosmosEventKey returns the following key format
+// prefix   event-nonce
+// [0x1c][0 0 0 0 0 0 0 1]
+func MakeParkedSendToCosmosEventKey(eventNonce uint64) []byte {
+	return append([]byte{ParkedSendToCosmosEventKey}, sdk.Uint64ToBigEndian(eventNonce)...)
+}
+
+// MakeERC20EscrowKey returns the following key format
+// prefix   eth-contract-address
+// [0x20][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakeERC20EscrowKey(contract common.Address) []byte {
+	return append([]byte{ERC20EscrowKey}, contract.Bytes()...)
+}
+
+// MakeOutflowBucketPrefix returns the following key format
+// prefix   length  denom
+// [0x1d][0x5][ugrav]
+func MakeOutflowBucketPrefix(denom string) []byte {
+	return append([]byte{OutflowBucketKey}, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// MakeOutflowBucketKey returns the following key format
+// prefix   length  denom  bucket-start-height
+// [0x1d][0x5][ugrav][0 0 0 0 0 0 0 1]
+func MakeOutflowBucketKey(denom string, bucketStart uint64) []byte {
+	return append(MakeOutflowBucketPrefix(denom), sdk.Uint64ToBigEndian(bucketStart)...)
+}
+
+// MakePendingSendToEthereumKey returns the following key format
+// prefix release-height        id
+// [0x1e][0 0 0 0 0 0 0 1][0 0 0 0 0 0 0 2]
+func MakePendingSendToEthereumKey(releaseHeight, id uint64) []byte {
+	return bytes.Join([][]byte{{PendingSendToEthereumKey}, sdk.Uint64ToBigEndian(releaseHeight), sdk.Uint64ToBigEndian(id)}, []byte{})
+}
+
+// MakeSendToEthereumExpiryKey returns the following key format
+// prefix expires-at-height     id
+// [0x21][0 0 0 0 0 0 0 1][0 0 0 0 0 0 0 2]
+func MakeSendToEthereumExpiryKey(expiresAtHeight, id uint64) []byte {
+	return bytes.Join([][]byte{{SendToEthereumExpiryKey}, sdk.Uint64ToBigEndian(expiresAtHeight), sdk.Uint64ToBigEndian(id)}, []byte{})
+}
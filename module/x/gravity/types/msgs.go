@@ -12,13 +12,18 @@ import (
 var (
 	_ sdk.Msg = &MsgDelegateKeys{}
 	_ sdk.Msg = &MsgSendToEthereum{}
+	_ sdk.Msg = &MsgSendToEthereumMulti{}
 	_ sdk.Msg = &MsgCancelSendToEthereum{}
+	_ sdk.Msg = &MsgIncreaseSendToEthereumFee{}
 	_ sdk.Msg = &MsgRequestBatchTx{}
 	_ sdk.Msg = &MsgSubmitEthereumEvent{}
 	_ sdk.Msg = &MsgSubmitEthereumTxConfirmation{}
+	_ sdk.Msg = &MsgSubmitBadSignatureEvidence{}
+	_ sdk.Msg = &MsgCancelPendingSendToEthereum{}
 
 	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitEthereumEvent{}
 	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitEthereumTxConfirmation{}
+	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitBadSignatureEvidence{}
 	_ cdctypes.UnpackInterfacesMessage = &EthereumEventVoteRecord{}
 )
 
@@ -208,6 +213,79 @@ func (msg MsgSendToEthereum) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{acc}
 }
 
+// NewMsgSendToEthereumMulti returns a new MsgSendToEthereumMulti
+func NewMsgSendToEthereumMulti(sender sdk.AccAddress, recipients []SendToEthereumRecipient) *MsgSendToEthereumMulti {
+	return &MsgSendToEthereumMulti{
+		Sender:     sender.String(),
+		Recipients: recipients,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSendToEthereumMulti) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSendToEthereumMulti) Type() string { return "send_to_eth_multi" }
+
+// ValidateBasic runs stateless checks on the message
+// Checks the recipients are valid and of a single denom
+func (msg MsgSendToEthereumMulti) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if len(msg.Recipients) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "no recipients")
+	}
+
+	denom := msg.Recipients[0].Amount.Denom
+	for i, recipient := range msg.Recipients {
+		if err := recipient.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "recipient %d", i)
+		}
+		if recipient.Amount.Denom != denom {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "recipient %d: amounts must be of the same type %s != %s", i, recipient.Amount.Denom, denom)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSendToEthereumMulti) GetSignBytes() []byte {
+	panic(fmt.Errorf("deprecated"))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSendToEthereumMulti) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// ValidateBasic runs the stateless checks of a MsgSendToEthereum on the recipient
+func (r SendToEthereumRecipient) ValidateBasic() error {
+	// fee and send must be of the same denom
+	if r.Amount.Denom != r.BridgeFee.Denom {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins,
+			fmt.Sprintf("fee and amount must be the same type %s != %s", r.Amount.Denom, r.BridgeFee.Denom))
+	}
+
+	if !r.Amount.IsValid() || r.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount")
+	}
+	if !r.BridgeFee.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "fee")
+	}
+	if !common.IsHexAddress(r.EthereumRecipient) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "ethereum address")
+	}
+
+	return nil
+}
+
 // NewMsgRequestBatchTx returns a new msgRequestBatch
 func NewMsgRequestBatchTx(denom string, signer sdk.AccAddress) *MsgRequestBatchTx {
 	return &MsgRequestBatchTx{
@@ -287,3 +365,128 @@ func (msg MsgCancelSendToEthereum) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{acc}
 }
+
+// NewMsgIncreaseSendToEthereumFee returns a new MsgIncreaseSendToEthereumFee
+func NewMsgIncreaseSendToEthereumFee(id uint64, sender sdk.AccAddress, additionalFee sdk.Coin) *MsgIncreaseSendToEthereumFee {
+	return &MsgIncreaseSendToEthereumFee{
+		Id:            id,
+		Sender:        sender.String(),
+		AdditionalFee: additionalFee,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgIncreaseSendToEthereumFee) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgIncreaseSendToEthereumFee) Type() string { return "increase_send_to_ethereum_fee" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgIncreaseSendToEthereumFee) ValidateBasic() error {
+	if msg.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "Id cannot be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if !msg.AdditionalFee.IsValid() || msg.AdditionalFee.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "additional fee")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgIncreaseSendToEthereumFee) GetSignBytes() []byte {
+	panic(fmt.Errorf("deprecated"))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgIncreaseSendToEthereumFee) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// Route should return the name of the module
+func (msg *MsgSubmitBadSignatureEvidence) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgSubmitBadSignatureEvidence) Type() string { return "submit_bad_signature_evidence" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgSubmitBadSignatureEvidence) ValidateBasic() (err error) {
+	if _, err = sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
+	}
+
+	if _, err = UnpackOutgoingTx(msg.Subject); err != nil {
+		return err
+	}
+
+	if len(msg.Signature) == 0 {
+		return ErrEmptyEthSig
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSubmitBadSignatureEvidence) GetSignBytes() []byte {
+	panic(fmt.Errorf("deprecated"))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgSubmitBadSignatureEvidence) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+func (msg *MsgSubmitBadSignatureEvidence) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var subject OutgoingTx
+	return unpacker.UnpackAny(msg.Subject, &subject)
+}
+
+// NewMsgCancelPendingSendToEthereum returns a new MsgCancelPendingSendToEthereum
+func NewMsgCancelPendingSendToEthereum(id uint64, guardian sdk.AccAddress) *MsgCancelPendingSendToEthereum {
+	return &MsgCancelPendingSendToEthereum{
+		Id:     id,
+		Signer: guardian.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgCancelPendingSendToEthereum) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgCancelPendingSendToEthereum) Type() string { return "cancel_pending_send_to_ethereum" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgCancelPendingSendToEthereum) ValidateBasic() error {
+	if msg.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "Id cannot be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCancelPendingSendToEthereum) GetSignBytes() []byte {
+	panic(fmt.Errorf("deprecated"))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgCancelPendingSendToEthereum) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
@@ -36,12 +36,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // MsgSendToEthereum submits a SendToEthereum attempt to bridge an asset over to
 // Ethereum. The SendToEthereum will be stored and then included in a batch and
-// then submitted to Ethereum.
+// then submitted to Ethereum. If expires_at_height is set, the SendToEthereum is
+// refunded once the chain reaches that height without it being batched.
 type MsgSendToEthereum struct {
 	Sender            string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	EthereumRecipient string     `protobuf:"bytes,2,opt,name=ethereum_recipient,json=ethereumRecipient,proto3" json:"ethereum_recipient,omitempty"`
 	Amount            types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 	BridgeFee         types.Coin `protobuf:"bytes,4,opt,name=bridge_fee,json=bridgeFee,proto3" json:"bridge_fee"`
+	ExpiresAtHeight   uint64     `protobuf:"varint,5,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
 }
 
 func (m *MsgSendToEthereum) Reset()         { *m = MsgSendToEthereum{} }
@@ -105,6 +107,13 @@ func (m *MsgSendToEthereum) GetBridgeFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgSendToEthereum) GetExpiresAtHeight() uint64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
+}
+
 // MsgSendToEthereumResponse returns the SendToEthereum transaction ID which
 // will be included in the batch tx.
 type MsgSendToEthereumResponse struct {
@@ -151,6 +160,178 @@ func (m *MsgSendToEthereumResponse) GetId() uint64 {
 	return 0
 }
 
+// MsgSendToEthereumMulti submits SendToEthereum attempts to many Ethereum
+// recipients at once. Every amount and bridge fee must be of the same denom.
+// The coins of all the SendToEthereums are taken from the sender together and
+// the SendToEthereums get consecutive IDs.
+type MsgSendToEthereumMulti struct {
+	Sender          string                    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipients      []SendToEthereumRecipient `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients"`
+	ExpiresAtHeight uint64                    `protobuf:"varint,3,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+}
+
+func (m *MsgSendToEthereumMulti) Reset()         { *m = MsgSendToEthereumMulti{} }
+func (m *MsgSendToEthereumMulti) String() string { return proto.CompactTextString(m) }
+func (*MsgSendToEthereumMulti) ProtoMessage()    {}
+func (*MsgSendToEthereumMulti) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{2}
+}
+func (m *MsgSendToEthereumMulti) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendToEthereumMulti) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendToEthereumMulti.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSendToEthereumMulti) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendToEthereumMulti.Merge(m, src)
+}
+func (m *MsgSendToEthereumMulti) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendToEthereumMulti) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendToEthereumMulti.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendToEthereumMulti proto.InternalMessageInfo
+
+func (m *MsgSendToEthereumMulti) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSendToEthereumMulti) GetRecipients() []SendToEthereumRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func (m *MsgSendToEthereumMulti) GetExpiresAtHeight() uint64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
+}
+
+// SendToEthereumRecipient is a single SendToEthereum of a
+// MsgSendToEthereumMulti
+type SendToEthereumRecipient struct {
+	EthereumRecipient string     `protobuf:"bytes,1,opt,name=ethereum_recipient,json=ethereumRecipient,proto3" json:"ethereum_recipient,omitempty"`
+	Amount            types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	BridgeFee         types.Coin `protobuf:"bytes,3,opt,name=bridge_fee,json=bridgeFee,proto3" json:"bridge_fee"`
+}
+
+func (m *SendToEthereumRecipient) Reset()         { *m = SendToEthereumRecipient{} }
+func (m *SendToEthereumRecipient) String() string { return proto.CompactTextString(m) }
+func (*SendToEthereumRecipient) ProtoMessage()    {}
+func (*SendToEthereumRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{3}
+}
+func (m *SendToEthereumRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendToEthereumRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendToEthereumRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendToEthereumRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendToEthereumRecipient.Merge(m, src)
+}
+func (m *SendToEthereumRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendToEthereumRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendToEthereumRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendToEthereumRecipient proto.InternalMessageInfo
+
+func (m *SendToEthereumRecipient) GetEthereumRecipient() string {
+	if m != nil {
+		return m.EthereumRecipient
+	}
+	return ""
+}
+
+func (m *SendToEthereumRecipient) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *SendToEthereumRecipient) GetBridgeFee() types.Coin {
+	if m != nil {
+		return m.BridgeFee
+	}
+	return types.Coin{}
+}
+
+// MsgSendToEthereumMultiResponse returns the IDs of the SendToEthereum
+// transactions, in the order of the recipients.
+type MsgSendToEthereumMultiResponse struct {
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (m *MsgSendToEthereumMultiResponse) Reset()         { *m = MsgSendToEthereumMultiResponse{} }
+func (m *MsgSendToEthereumMultiResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendToEthereumMultiResponse) ProtoMessage()    {}
+func (*MsgSendToEthereumMultiResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{4}
+}
+func (m *MsgSendToEthereumMultiResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendToEthereumMultiResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendToEthereumMultiResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSendToEthereumMultiResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendToEthereumMultiResponse.Merge(m, src)
+}
+func (m *MsgSendToEthereumMultiResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendToEthereumMultiResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendToEthereumMultiResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendToEthereumMultiResponse proto.InternalMessageInfo
+
+func (m *MsgSendToEthereumMultiResponse) GetIds() []uint64 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
 // MsgCancelSendToEthereum allows the sender to cancel its own outgoing
 // SendToEthereum tx and recieve a refund of the tokens and bridge fees. This tx
 // will only succeed if the SendToEthereum tx hasn't been batched to be
@@ -164,7 +345,7 @@ func (m *MsgCancelSendToEthereum) Reset()         { *m = MsgCancelSendToEthereum
 func (m *MsgCancelSendToEthereum) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelSendToEthereum) ProtoMessage()    {}
 func (*MsgCancelSendToEthereum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{2}
+	return fileDescriptor_2f8523f2f6feb451, []int{5}
 }
 func (m *MsgCancelSendToEthereum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,7 +395,7 @@ func (m *MsgCancelSendToEthereumResponse) Reset()         { *m = MsgCancelSendTo
 func (m *MsgCancelSendToEthereumResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelSendToEthereumResponse) ProtoMessage()    {}
 func (*MsgCancelSendToEthereumResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{3}
+	return fileDescriptor_2f8523f2f6feb451, []int{6}
 }
 func (m *MsgCancelSendToEthereumResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,6 +424,199 @@ func (m *MsgCancelSendToEthereumResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelSendToEthereumResponse proto.InternalMessageInfo
 
+// MsgIncreaseSendToEthereumFee allows the sender to add to the bridge fee of
+// its own outgoing SendToEthereum tx, so it is batched ahead of lower fee txs
+// without changing its ID. The additional fee must be of the same denom as the
+// tx. This tx will only succeed if the SendToEthereum tx hasn't been batched.
+type MsgIncreaseSendToEthereumFee struct {
+	Id            uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sender        string     `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	AdditionalFee types.Coin `protobuf:"bytes,3,opt,name=additional_fee,json=additionalFee,proto3" json:"additional_fee"`
+}
+
+func (m *MsgIncreaseSendToEthereumFee) Reset()         { *m = MsgIncreaseSendToEthereumFee{} }
+func (m *MsgIncreaseSendToEthereumFee) String() string { return proto.CompactTextString(m) }
+func (*MsgIncreaseSendToEthereumFee) ProtoMessage()    {}
+func (*MsgIncreaseSendToEthereumFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{7}
+}
+func (m *MsgIncreaseSendToEthereumFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIncreaseSendToEthereumFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIncreaseSendToEthereumFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIncreaseSendToEthereumFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIncreaseSendToEthereumFee.Merge(m, src)
+}
+func (m *MsgIncreaseSendToEthereumFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIncreaseSendToEthereumFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIncreaseSendToEthereumFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIncreaseSendToEthereumFee proto.InternalMessageInfo
+
+func (m *MsgIncreaseSendToEthereumFee) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgIncreaseSendToEthereumFee) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgIncreaseSendToEthereumFee) GetAdditionalFee() types.Coin {
+	if m != nil {
+		return m.AdditionalFee
+	}
+	return types.Coin{}
+}
+
+type MsgIncreaseSendToEthereumFeeResponse struct {
+}
+
+func (m *MsgIncreaseSendToEthereumFeeResponse) Reset()         { *m = MsgIncreaseSendToEthereumFeeResponse{} }
+func (m *MsgIncreaseSendToEthereumFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgIncreaseSendToEthereumFeeResponse) ProtoMessage()    {}
+func (*MsgIncreaseSendToEthereumFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{8}
+}
+func (m *MsgIncreaseSendToEthereumFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIncreaseSendToEthereumFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIncreaseSendToEthereumFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIncreaseSendToEthereumFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIncreaseSendToEthereumFeeResponse.Merge(m, src)
+}
+func (m *MsgIncreaseSendToEthereumFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIncreaseSendToEthereumFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIncreaseSendToEthereumFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIncreaseSendToEthereumFeeResponse proto.InternalMessageInfo
+
+// MsgCancelPendingSendToEthereum allows the withdrawal guardian to cancel a
+// large SendToEthereum tx that is still held, refunding the tokens and bridge
+// fees to its sender.
+type MsgCancelPendingSendToEthereum struct {
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgCancelPendingSendToEthereum) Reset()         { *m = MsgCancelPendingSendToEthereum{} }
+func (m *MsgCancelPendingSendToEthereum) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPendingSendToEthereum) ProtoMessage()    {}
+func (*MsgCancelPendingSendToEthereum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{9}
+}
+func (m *MsgCancelPendingSendToEthereum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPendingSendToEthereum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPendingSendToEthereum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelPendingSendToEthereum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPendingSendToEthereum.Merge(m, src)
+}
+func (m *MsgCancelPendingSendToEthereum) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPendingSendToEthereum) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPendingSendToEthereum.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelPendingSendToEthereum proto.InternalMessageInfo
+
+func (m *MsgCancelPendingSendToEthereum) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgCancelPendingSendToEthereum) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+type MsgCancelPendingSendToEthereumResponse struct {
+}
+
+func (m *MsgCancelPendingSendToEthereumResponse) Reset() {
+	*m = MsgCancelPendingSendToEthereumResponse{}
+}
+func (m *MsgCancelPendingSendToEthereumResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPendingSendToEthereumResponse) ProtoMessage()    {}
+func (*MsgCancelPendingSendToEthereumResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{10}
+}
+func (m *MsgCancelPendingSendToEthereumResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPendingSendToEthereumResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPendingSendToEthereumResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelPendingSendToEthereumResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPendingSendToEthereumResponse.Merge(m, src)
+}
+func (m *MsgCancelPendingSendToEthereumResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPendingSendToEthereumResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPendingSendToEthereumResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelPendingSendToEthereumResponse proto.InternalMessageInfo
+
 // MsgRequestBatchTx requests a batch of transactions with a given coin
 // denomination to send across the bridge to Ethereum.
 type MsgRequestBatchTx struct {
@@ -254,7 +628,7 @@ func (m *MsgRequestBatchTx) Reset()         { *m = MsgRequestBatchTx{} }
 func (m *MsgRequestBatchTx) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestBatchTx) ProtoMessage()    {}
 func (*MsgRequestBatchTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{4}
+	return fileDescriptor_2f8523f2f6feb451, []int{11}
 }
 func (m *MsgRequestBatchTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -304,7 +678,7 @@ func (m *MsgRequestBatchTxResponse) Reset()         { *m = MsgRequestBatchTxResp
 func (m *MsgRequestBatchTxResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestBatchTxResponse) ProtoMessage()    {}
 func (*MsgRequestBatchTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{5}
+	return fileDescriptor_2f8523f2f6feb451, []int{12}
 }
 func (m *MsgRequestBatchTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -345,7 +719,7 @@ func (m *MsgSubmitEthereumTxConfirmation) Reset()         { *m = MsgSubmitEthere
 func (m *MsgSubmitEthereumTxConfirmation) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitEthereumTxConfirmation) ProtoMessage()    {}
 func (*MsgSubmitEthereumTxConfirmation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{6}
+	return fileDescriptor_2f8523f2f6feb451, []int{13}
 }
 func (m *MsgSubmitEthereumTxConfirmation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,7 +761,7 @@ func (m *ContractCallTxConfirmation) Reset()         { *m = ContractCallTxConfir
 func (m *ContractCallTxConfirmation) String() string { return proto.CompactTextString(m) }
 func (*ContractCallTxConfirmation) ProtoMessage()    {}
 func (*ContractCallTxConfirmation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{7}
+	return fileDescriptor_2f8523f2f6feb451, []int{14}
 }
 func (m *ContractCallTxConfirmation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,7 +830,7 @@ func (m *BatchTxConfirmation) Reset()         { *m = BatchTxConfirmation{} }
 func (m *BatchTxConfirmation) String() string { return proto.CompactTextString(m) }
 func (*BatchTxConfirmation) ProtoMessage()    {}
 func (*BatchTxConfirmation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{8}
+	return fileDescriptor_2f8523f2f6feb451, []int{15}
 }
 func (m *BatchTxConfirmation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -525,7 +899,7 @@ func (m *SignerSetTxConfirmation) Reset()         { *m = SignerSetTxConfirmation
 func (m *SignerSetTxConfirmation) String() string { return proto.CompactTextString(m) }
 func (*SignerSetTxConfirmation) ProtoMessage()    {}
 func (*SignerSetTxConfirmation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{9}
+	return fileDescriptor_2f8523f2f6feb451, []int{16}
 }
 func (m *SignerSetTxConfirmation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -584,7 +958,7 @@ func (m *MsgSubmitEthereumTxConfirmationResponse) Reset() {
 func (m *MsgSubmitEthereumTxConfirmationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitEthereumTxConfirmationResponse) ProtoMessage()    {}
 func (*MsgSubmitEthereumTxConfirmationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{10}
+	return fileDescriptor_2f8523f2f6feb451, []int{17}
 }
 func (m *MsgSubmitEthereumTxConfirmationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -623,7 +997,7 @@ func (m *MsgSubmitEthereumEvent) Reset()         { *m = MsgSubmitEthereumEvent{}
 func (m *MsgSubmitEthereumEvent) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitEthereumEvent) ProtoMessage()    {}
 func (*MsgSubmitEthereumEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{11}
+	return fileDescriptor_2f8523f2f6feb451, []int{18}
 }
 func (m *MsgSubmitEthereumEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,7 +1033,7 @@ func (m *MsgSubmitEthereumEventResponse) Reset()         { *m = MsgSubmitEthereu
 func (m *MsgSubmitEthereumEventResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitEthereumEventResponse) ProtoMessage()    {}
 func (*MsgSubmitEthereumEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{12}
+	return fileDescriptor_2f8523f2f6feb451, []int{19}
 }
 func (m *MsgSubmitEthereumEventResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -702,7 +1076,7 @@ func (m *MsgDelegateKeys) Reset()         { *m = MsgDelegateKeys{} }
 func (m *MsgDelegateKeys) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateKeys) ProtoMessage()    {}
 func (*MsgDelegateKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{13}
+	return fileDescriptor_2f8523f2f6feb451, []int{20}
 }
 func (m *MsgDelegateKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -766,7 +1140,7 @@ func (m *MsgDelegateKeysResponse) Reset()         { *m = MsgDelegateKeysResponse
 func (m *MsgDelegateKeysResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateKeysResponse) ProtoMessage()    {}
 func (*MsgDelegateKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{14}
+	return fileDescriptor_2f8523f2f6feb451, []int{21}
 }
 func (m *MsgDelegateKeysResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +1169,85 @@ func (m *MsgDelegateKeysResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDelegateKeysResponse proto.InternalMessageInfo
 
-// DelegateKeysSignMsg defines the message structure an operator is expected to
+// MsgSubmitBadSignatureEvidence submits evidence that a validator's ethereum
+// key signed the checkpoint of an outgoing tx that was never produced by the
+// chain. The validator is slashed and tombstoned if the evidence is valid.
+type MsgSubmitBadSignatureEvidence struct {
+	Subject   *types1.Any `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Signature []byte      `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Signer    string      `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgSubmitBadSignatureEvidence) Reset()         { *m = MsgSubmitBadSignatureEvidence{} }
+func (m *MsgSubmitBadSignatureEvidence) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBadSignatureEvidence) ProtoMessage()    {}
+func (*MsgSubmitBadSignatureEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{22}
+}
+func (m *MsgSubmitBadSignatureEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitBadSignatureEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitBadSignatureEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitBadSignatureEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBadSignatureEvidence.Merge(m, src)
+}
+func (m *MsgSubmitBadSignatureEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitBadSignatureEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBadSignatureEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitBadSignatureEvidence proto.InternalMessageInfo
+
+type MsgSubmitBadSignatureEvidenceResponse struct {
+}
+
+func (m *MsgSubmitBadSignatureEvidenceResponse) Reset()         { *m = MsgSubmitBadSignatureEvidenceResponse{} }
+func (m *MsgSubmitBadSignatureEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBadSignatureEvidenceResponse) ProtoMessage()    {}
+func (*MsgSubmitBadSignatureEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{23}
+}
+func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitBadSignatureEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBadSignatureEvidenceResponse.Merge(m, src)
+}
+func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBadSignatureEvidenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitBadSignatureEvidenceResponse proto.InternalMessageInfo
+
+// DelegateKeysSignMsg defines the message structure an operator is expected to
 // sign when submitting a MsgDelegateKeys message. The resulting signature should
 // populate the eth_signature field.
 type DelegateKeysSignMsg struct {
@@ -807,7 +1259,7 @@ func (m *DelegateKeysSignMsg) Reset()         { *m = DelegateKeysSignMsg{} }
 func (m *DelegateKeysSignMsg) String() string { return proto.CompactTextString(m) }
 func (*DelegateKeysSignMsg) ProtoMessage()    {}
 func (*DelegateKeysSignMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{15}
+	return fileDescriptor_2f8523f2f6feb451, []int{24}
 }
 func (m *DelegateKeysSignMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -866,7 +1318,7 @@ func (m *SendToCosmosEvent) Reset()         { *m = SendToCosmosEvent{} }
 func (m *SendToCosmosEvent) String() string { return proto.CompactTextString(m) }
 func (*SendToCosmosEvent) ProtoMessage()    {}
 func (*SendToCosmosEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{16}
+	return fileDescriptor_2f8523f2f6feb451, []int{25}
 }
 func (m *SendToCosmosEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -943,7 +1395,7 @@ func (m *BatchExecutedEvent) Reset()         { *m = BatchExecutedEvent{} }
 func (m *BatchExecutedEvent) String() string { return proto.CompactTextString(m) }
 func (*BatchExecutedEvent) ProtoMessage()    {}
 func (*BatchExecutedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{17}
+	return fileDescriptor_2f8523f2f6feb451, []int{26}
 }
 func (m *BatchExecutedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1013,7 +1465,7 @@ func (m *ContractCallExecutedEvent) Reset()         { *m = ContractCallExecutedE
 func (m *ContractCallExecutedEvent) String() string { return proto.CompactTextString(m) }
 func (*ContractCallExecutedEvent) ProtoMessage()    {}
 func (*ContractCallExecutedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{18}
+	return fileDescriptor_2f8523f2f6feb451, []int{27}
 }
 func (m *ContractCallExecutedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1086,7 +1538,7 @@ func (m *ERC20DeployedEvent) Reset()         { *m = ERC20DeployedEvent{} }
 func (m *ERC20DeployedEvent) String() string { return proto.CompactTextString(m) }
 func (*ERC20DeployedEvent) ProtoMessage()    {}
 func (*ERC20DeployedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{19}
+	return fileDescriptor_2f8523f2f6feb451, []int{28}
 }
 func (m *ERC20DeployedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1177,7 +1629,7 @@ func (m *SignerSetTxExecutedEvent) Reset()         { *m = SignerSetTxExecutedEve
 func (m *SignerSetTxExecutedEvent) String() string { return proto.CompactTextString(m) }
 func (*SignerSetTxExecutedEvent) ProtoMessage()    {}
 func (*SignerSetTxExecutedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{20}
+	return fileDescriptor_2f8523f2f6feb451, []int{29}
 }
 func (m *SignerSetTxExecutedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1237,8 +1689,15 @@ func (m *SignerSetTxExecutedEvent) GetMembers() []*EthereumSigner {
 func init() {
 	proto.RegisterType((*MsgSendToEthereum)(nil), "gravity.v1.MsgSendToEthereum")
 	proto.RegisterType((*MsgSendToEthereumResponse)(nil), "gravity.v1.MsgSendToEthereumResponse")
+	proto.RegisterType((*MsgSendToEthereumMulti)(nil), "gravity.v1.MsgSendToEthereumMulti")
+	proto.RegisterType((*SendToEthereumRecipient)(nil), "gravity.v1.SendToEthereumRecipient")
+	proto.RegisterType((*MsgSendToEthereumMultiResponse)(nil), "gravity.v1.MsgSendToEthereumMultiResponse")
 	proto.RegisterType((*MsgCancelSendToEthereum)(nil), "gravity.v1.MsgCancelSendToEthereum")
 	proto.RegisterType((*MsgCancelSendToEthereumResponse)(nil), "gravity.v1.MsgCancelSendToEthereumResponse")
+	proto.RegisterType((*MsgIncreaseSendToEthereumFee)(nil), "gravity.v1.MsgIncreaseSendToEthereumFee")
+	proto.RegisterType((*MsgIncreaseSendToEthereumFeeResponse)(nil), "gravity.v1.MsgIncreaseSendToEthereumFeeResponse")
+	proto.RegisterType((*MsgCancelPendingSendToEthereum)(nil), "gravity.v1.MsgCancelPendingSendToEthereum")
+	proto.RegisterType((*MsgCancelPendingSendToEthereumResponse)(nil), "gravity.v1.MsgCancelPendingSendToEthereumResponse")
 	proto.RegisterType((*MsgRequestBatchTx)(nil), "gravity.v1.MsgRequestBatchTx")
 	proto.RegisterType((*MsgRequestBatchTxResponse)(nil), "gravity.v1.MsgRequestBatchTxResponse")
 	proto.RegisterType((*MsgSubmitEthereumTxConfirmation)(nil), "gravity.v1.MsgSubmitEthereumTxConfirmation")
@@ -1250,6 +1709,8 @@ func init() {
 	proto.RegisterType((*MsgSubmitEthereumEventResponse)(nil), "gravity.v1.MsgSubmitEthereumEventResponse")
 	proto.RegisterType((*MsgDelegateKeys)(nil), "gravity.v1.MsgDelegateKeys")
 	proto.RegisterType((*MsgDelegateKeysResponse)(nil), "gravity.v1.MsgDelegateKeysResponse")
+	proto.RegisterType((*MsgSubmitBadSignatureEvidence)(nil), "gravity.v1.MsgSubmitBadSignatureEvidence")
+	proto.RegisterType((*MsgSubmitBadSignatureEvidenceResponse)(nil), "gravity.v1.MsgSubmitBadSignatureEvidenceResponse")
 	proto.RegisterType((*DelegateKeysSignMsg)(nil), "gravity.v1.DelegateKeysSignMsg")
 	proto.RegisterType((*SendToCosmosEvent)(nil), "gravity.v1.SendToCosmosEvent")
 	proto.RegisterType((*BatchExecutedEvent)(nil), "gravity.v1.BatchExecutedEvent")
@@ -1261,88 +1722,107 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/msgs.proto", fileDescriptor_2f8523f2f6feb451) }
 
 var fileDescriptor_2f8523f2f6feb451 = []byte{
-	// 1289 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0x16, 0x25, 0xd9, 0x81, 0x47, 0xfe, 0xa4, 0xfd, 0x26, 0xb2, 0x92, 0x48, 0x8e, 0x82, 0xbc,
-	0x71, 0x1a, 0x88, 0x8c, 0x9d, 0x00, 0x0d, 0x02, 0xb4, 0x80, 0x25, 0x3b, 0x48, 0x51, 0x38, 0x07,
-	0xca, 0x05, 0x82, 0x5e, 0x04, 0x8a, 0x9c, 0x50, 0x4c, 0x44, 0xae, 0xca, 0x5d, 0x09, 0xd6, 0xb5,
-	0xa7, 0xa2, 0xa7, 0xf6, 0xd0, 0x7b, 0x0e, 0x41, 0x7f, 0x41, 0xfe, 0x40, 0x6e, 0x69, 0x4e, 0x01,
-	0x7a, 0x29, 0x7a, 0x08, 0x8a, 0xe4, 0x52, 0xf4, 0x27, 0x14, 0x28, 0x50, 0x70, 0x77, 0x29, 0x93,
-	0x14, 0xfd, 0x05, 0xf4, 0x64, 0xee, 0xcc, 0xb3, 0xb3, 0xcf, 0xcc, 0x3e, 0x9a, 0x59, 0xc3, 0xff,
-	0x9c, 0xc0, 0x1c, 0xb9, 0x6c, 0xac, 0x8f, 0xb6, 0x74, 0x8f, 0x3a, 0x54, 0x1b, 0x04, 0x84, 0x11,
-	0x15, 0xa4, 0x59, 0x1b, 0x6d, 0x55, 0xaa, 0x16, 0xa1, 0x1e, 0xa1, 0x7a, 0xd7, 0xa4, 0xa8, 0x8f,
-	0xb6, 0xba, 0xc8, 0xcc, 0x2d, 0xdd, 0x22, 0xae, 0x2f, 0xb0, 0x95, 0x75, 0xe1, 0xef, 0xf0, 0x95,
-	0x2e, 0x16, 0xd2, 0x55, 0x8e, 0x45, 0x8f, 0x22, 0x0a, 0xcf, 0x9a, 0x43, 0x1c, 0x22, 0x76, 0x84,
-	0x5f, 0xd2, 0x7a, 0xc5, 0x21, 0xc4, 0xe9, 0xa3, 0x6e, 0x0e, 0x5c, 0xdd, 0xf4, 0x7d, 0xc2, 0x4c,
-	0xe6, 0x12, 0x3f, 0x8a, 0xb6, 0x2e, 0xbd, 0x7c, 0xd5, 0x1d, 0x3e, 0xd5, 0x4d, 0x5f, 0x86, 0xab,
-	0xff, 0xaa, 0xc0, 0xca, 0x3e, 0x75, 0xda, 0xe8, 0xdb, 0x07, 0x64, 0x8f, 0xf5, 0x30, 0xc0, 0xa1,
-	0xa7, 0x5e, 0x84, 0x59, 0x8a, 0xbe, 0x8d, 0x41, 0x59, 0xd9, 0x50, 0x36, 0xe7, 0x0c, 0xb9, 0x52,
-	0x1b, 0xa0, 0xa2, 0xc4, 0x74, 0x02, 0xb4, 0xdc, 0x81, 0x8b, 0x3e, 0x2b, 0xe7, 0x39, 0x66, 0x25,
-	0xf2, 0x18, 0x91, 0x43, 0xfd, 0x14, 0x66, 0x4d, 0x8f, 0x0c, 0x7d, 0x56, 0x2e, 0x6c, 0x28, 0x9b,
-	0xa5, 0xed, 0x75, 0x4d, 0x26, 0x19, 0x56, 0x44, 0x93, 0x15, 0xd1, 0x5a, 0xc4, 0xf5, 0x9b, 0xc5,
-	0x37, 0xef, 0x6b, 0x39, 0x43, 0xc2, 0xd5, 0xcf, 0x01, 0xba, 0x81, 0x6b, 0x3b, 0xd8, 0x79, 0x8a,
-	0x58, 0x2e, 0x9e, 0x6d, 0xf3, 0x9c, 0xd8, 0xf2, 0x10, 0xb1, 0x7e, 0x1b, 0xd6, 0xa7, 0x92, 0x32,
-	0x90, 0x0e, 0x88, 0x4f, 0x51, 0x5d, 0x84, 0xbc, 0x6b, 0xf3, 0xc4, 0x8a, 0x46, 0xde, 0xb5, 0xeb,
-	0x3b, 0x70, 0x69, 0x9f, 0x3a, 0x2d, 0xd3, 0xb7, 0xb0, 0x9f, 0xaa, 0x43, 0x0a, 0x1a, 0xab, 0x4b,
-	0x3e, 0x5e, 0x97, 0xfa, 0x35, 0xa8, 0x1d, 0x13, 0x22, 0x3a, 0xb5, 0xbe, 0xc3, 0xeb, 0x6c, 0xe0,
-	0x37, 0x43, 0xa4, 0xac, 0x69, 0x32, 0xab, 0x77, 0x70, 0xa8, 0xae, 0xc1, 0x8c, 0x8d, 0x3e, 0xf1,
-	0x64, 0x99, 0xc5, 0x82, 0x9f, 0xe2, 0x3a, 0x7e, 0xec, 0x14, 0xbe, 0xaa, 0x5f, 0xe6, 0x59, 0x25,
-	0x43, 0x4c, 0xe2, 0xff, 0xa4, 0x70, 0x0e, 0xed, 0x61, 0xd7, 0x73, 0x59, 0x74, 0xfa, 0xc1, 0x61,
-	0x8b, 0xf8, 0x4f, 0xdd, 0xc0, 0xe3, 0x72, 0x50, 0x0f, 0x60, 0xde, 0x8a, 0xad, 0xf9, 0xa9, 0xa5,
-	0xed, 0x35, 0x4d, 0xc8, 0x43, 0x8b, 0xe4, 0xa1, 0xed, 0xf8, 0xe3, 0x66, 0xe5, 0xed, 0xab, 0xc6,
-	0xc5, 0xec, 0x38, 0x46, 0x22, 0xca, 0x71, 0x74, 0x1f, 0x14, 0xbf, 0x7b, 0x51, 0xcb, 0xd5, 0x5f,
-	0x2b, 0x50, 0x69, 0x11, 0x9f, 0x05, 0xa6, 0xc5, 0x5a, 0x66, 0xbf, 0x9f, 0xa2, 0xd4, 0x00, 0xd5,
-	0xf5, 0x47, 0x66, 0xdf, 0xb5, 0xf9, 0xba, 0x43, 0x2d, 0x32, 0x40, 0x4e, 0x6c, 0xde, 0x58, 0x89,
-	0x7b, 0xda, 0xa1, 0x63, 0x0a, 0xee, 0x13, 0xdf, 0x42, 0x7e, 0x6e, 0x31, 0x09, 0x7f, 0x1c, 0x3a,
-	0xd4, 0x9b, 0xb0, 0x34, 0xd1, 0xab, 0xe4, 0x58, 0xe0, 0x1c, 0x17, 0x23, 0x73, 0x9b, 0x5b, 0xd5,
-	0x2b, 0x30, 0x17, 0xfa, 0x4d, 0x36, 0x0c, 0x84, 0xde, 0xe6, 0x8d, 0x23, 0x43, 0xfd, 0xa5, 0x02,
-	0xab, 0xb2, 0xde, 0x09, 0xf2, 0x37, 0x60, 0x91, 0x91, 0xe7, 0xe8, 0x77, 0x2c, 0x99, 0xa0, 0xbc,
-	0xc7, 0x05, 0x6e, 0x8d, 0xb2, 0x56, 0x6b, 0x50, 0xea, 0x86, 0xbb, 0x13, 0x6c, 0x81, 0x9b, 0xfe,
-	0x53, 0x9a, 0xdf, 0x2b, 0x70, 0x49, 0x00, 0xdb, 0xc8, 0x52, 0x54, 0x37, 0x61, 0x59, 0x44, 0xee,
-	0x50, 0x64, 0x92, 0x88, 0xd0, 0xf5, 0x22, 0x8d, 0xb6, 0x1c, 0x4b, 0x26, 0x7f, 0x3a, 0x99, 0x42,
-	0x9a, 0xcc, 0x2d, 0xb8, 0x79, 0x8a, 0x1c, 0x27, 0xd2, 0x1d, 0xc2, 0xc5, 0x29, 0xe8, 0xde, 0x28,
-	0x6c, 0x20, 0x9f, 0xc1, 0x0c, 0x86, 0x1f, 0x27, 0x2a, 0x75, 0xe5, 0xed, 0xab, 0xc6, 0x42, 0x62,
-	0x9f, 0x21, 0x76, 0x9d, 0xa2, 0xcc, 0x0d, 0xa8, 0x66, 0x1f, 0x3b, 0x21, 0xf6, 0x5a, 0x81, 0xa5,
-	0x7d, 0xea, 0xec, 0x62, 0x1f, 0x1d, 0x93, 0xe1, 0x97, 0x38, 0xa6, 0xea, 0x6d, 0x58, 0x91, 0x2a,
-	0x23, 0x41, 0xc7, 0xb4, 0xed, 0x00, 0x29, 0x95, 0xd7, 0xbe, 0x3c, 0x71, 0xec, 0x08, 0xbb, 0xba,
-	0x05, 0x6b, 0x24, 0xb0, 0x7a, 0x48, 0x59, 0x90, 0xc0, 0x0b, 0x3a, 0xab, 0x71, 0x5f, 0xb4, 0xe5,
-	0x16, 0x2c, 0x4f, 0xca, 0x1f, 0xc1, 0x85, 0x18, 0x26, 0xd7, 0x12, 0x41, 0xaf, 0xc3, 0x02, 0xb2,
-	0x5e, 0x27, 0xad, 0x88, 0x79, 0x64, 0xbd, 0xf6, 0xe4, 0x1e, 0xd6, 0x79, 0x77, 0x8b, 0xa7, 0x30,
-	0x49, 0xef, 0x09, 0xac, 0xc6, 0xed, 0xe1, 0x9e, 0x7d, 0xea, 0x9c, 0x2f, 0xc3, 0x35, 0x98, 0x89,
-	0xab, 0x5a, 0x2c, 0xea, 0x2f, 0xf3, 0xb0, 0x22, 0xfa, 0x60, 0x8b, 0xf7, 0x6c, 0x71, 0x9b, 0x35,
-	0x28, 0xf1, 0x7b, 0x49, 0xc8, 0x0f, 0xb8, 0x49, 0x48, 0x6f, 0xfa, 0xf7, 0x94, 0xcf, 0xfa, 0x3d,
-	0x3d, 0x4c, 0x8c, 0x95, 0xb9, 0xa6, 0x16, 0xb6, 0xff, 0xdf, 0xdf, 0xd7, 0xfe, 0xef, 0xb8, 0xac,
-	0x37, 0xec, 0x6a, 0x16, 0xf1, 0xe4, 0x34, 0x95, 0x7f, 0x1a, 0xd4, 0x7e, 0xae, 0xb3, 0xf1, 0x00,
-	0xa9, 0xf6, 0x85, 0xcf, 0x26, 0x53, 0x26, 0xa1, 0x74, 0xd1, 0xd6, 0x8b, 0x29, 0xa5, 0x8b, 0xb1,
-	0x77, 0x13, 0x96, 0xe4, 0xa8, 0x0e, 0xd0, 0x42, 0x77, 0x84, 0x41, 0x79, 0x46, 0x00, 0x85, 0xd9,
-	0x90, 0xd6, 0x44, 0xc4, 0x1e, 0xba, 0x4e, 0x8f, 0x95, 0x67, 0xc5, 0x8f, 0x2c, 0x32, 0x3f, 0xe2,
-	0xd6, 0x07, 0xc5, 0x3f, 0x5f, 0xd4, 0x94, 0xfa, 0xcf, 0x0a, 0xa8, 0xbc, 0xaf, 0xec, 0x1d, 0xa2,
-	0x35, 0x64, 0x68, 0x8b, 0x3a, 0x9d, 0xbd, 0xad, 0xc4, 0xcb, 0x99, 0x9f, 0x2a, 0x67, 0x06, 0x9b,
-	0x42, 0x16, 0x9b, 0x74, 0x83, 0x2a, 0xa6, 0x1b, 0x54, 0xfd, 0x1f, 0x05, 0xd6, 0xe3, 0x4d, 0x3c,
-	0xc9, 0xf7, 0xd4, 0x7b, 0x75, 0x32, 0x9b, 0x7c, 0x48, 0x78, 0xbe, 0x79, 0xff, 0xef, 0xf7, 0xb5,
-	0x7b, 0xb1, 0x8b, 0x63, 0xbc, 0xe4, 0x9e, 0xeb, 0xb3, 0xf8, 0x67, 0xdf, 0xed, 0x52, 0xbd, 0x3b,
-	0x66, 0x48, 0xb5, 0x47, 0x78, 0xd8, 0x0c, 0x3f, 0xce, 0x3e, 0x1e, 0x0a, 0x67, 0x19, 0x0f, 0xb2,
-	0x40, 0xc5, 0xac, 0x02, 0xd5, 0x7f, 0xcc, 0x83, 0xba, 0x67, 0xb4, 0xb6, 0xef, 0xec, 0xe2, 0xa0,
-	0x4f, 0xc6, 0x67, 0x4e, 0xfc, 0x5a, 0x38, 0x70, 0xb9, 0x70, 0xc4, 0x98, 0x17, 0x72, 0x2e, 0x09,
-	0xdb, 0x2e, 0x1f, 0xf6, 0xd3, 0x97, 0x5d, 0xc8, 0xba, 0xec, 0xab, 0x00, 0x18, 0x58, 0xdb, 0x77,
-	0x3a, 0xbe, 0xe9, 0xa1, 0x94, 0xe9, 0x1c, 0xb7, 0x3c, 0x36, 0x3d, 0x7e, 0x90, 0x70, 0xd3, 0xb1,
-	0xd7, 0x25, 0x7d, 0x29, 0xcf, 0x12, 0xb7, 0xb5, 0xb9, 0x29, 0x3c, 0x48, 0x40, 0x6c, 0xb4, 0x5c,
-	0xcf, 0xec, 0x53, 0x29, 0xcd, 0x05, 0x6e, 0xdd, 0x95, 0xc6, 0xac, 0x9a, 0x5c, 0xc8, 0xac, 0xc9,
-	0x2f, 0x0a, 0x94, 0x63, 0xd3, 0xe6, 0x9c, 0x92, 0x68, 0xc0, 0x6a, 0x6c, 0x1e, 0xb1, 0xc3, 0x84,
-	0x88, 0x97, 0xe9, 0x51, 0xdc, 0x73, 0x4a, 0xf9, 0x1e, 0x5c, 0xf0, 0xd0, 0xeb, 0x62, 0x40, 0xcb,
-	0xc5, 0x8d, 0xc2, 0x66, 0x69, 0xbb, 0xa2, 0x1d, 0xbd, 0xc8, 0xb5, 0xbd, 0xc4, 0x04, 0x33, 0x22,
-	0xe8, 0xf6, 0x5f, 0x45, 0x28, 0x84, 0xad, 0xef, 0x09, 0x2c, 0xa6, 0x5e, 0x80, 0x57, 0xe3, 0xdb,
-	0xa7, 0xde, 0x94, 0x95, 0x1b, 0x27, 0xba, 0x27, 0x9d, 0x36, 0xa7, 0x3e, 0x83, 0xb5, 0xcc, 0x17,
-	0xe6, 0xf5, 0x54, 0x80, 0x2c, 0x50, 0xe5, 0xf6, 0x19, 0x40, 0xb1, 0xb3, 0x9e, 0xc0, 0x62, 0xea,
-	0x9d, 0x99, 0xce, 0x22, 0xe9, 0x9e, 0xca, 0xe2, 0x98, 0x27, 0x66, 0x4e, 0xfd, 0x56, 0x81, 0x2b,
-	0x27, 0xbe, 0x30, 0xd3, 0x4c, 0x4f, 0x02, 0x57, 0xee, 0x9e, 0x03, 0x1c, 0x23, 0xe1, 0xc0, 0x6a,
-	0xd6, 0x5b, 0xa1, 0x7e, 0x62, 0x34, 0x8e, 0xa9, 0x7c, 0x72, 0x3a, 0x26, 0x76, 0xd0, 0x57, 0xb0,
-	0xd4, 0x46, 0x96, 0x98, 0xfe, 0x97, 0x53, 0x01, 0xe2, 0xce, 0xca, 0xf5, 0x13, 0x9c, 0x47, 0x61,
-	0x9b, 0xc6, 0x9b, 0x0f, 0x55, 0xe5, 0xdd, 0x87, 0xaa, 0xf2, 0xc7, 0x87, 0xaa, 0xf2, 0xc3, 0xc7,
-	0x6a, 0xee, 0xdd, 0xc7, 0x6a, 0xee, 0xb7, 0x8f, 0xd5, 0xdc, 0xd7, 0xf7, 0x63, 0x7d, 0x70, 0x80,
-	0x8e, 0x33, 0x7e, 0x36, 0x8a, 0xfe, 0xfb, 0x6b, 0x88, 0x7f, 0x6e, 0x74, 0x8f, 0xd8, 0xc3, 0x3e,
-	0xea, 0x87, 0x91, 0x5d, 0x8c, 0xb5, 0xee, 0x2c, 0x7f, 0x11, 0xdd, 0xfd, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0x83, 0xc2, 0xd3, 0xe2, 0x96, 0x0e, 0x00, 0x00,
+	// 1587 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x41, 0x6f, 0xdb, 0x46,
+	0x16, 0x36, 0x25, 0xd9, 0x59, 0x3f, 0xdb, 0xb2, 0x4d, 0x7b, 0x1d, 0x89, 0x71, 0x24, 0x87, 0x5e,
+	0x27, 0x8a, 0x03, 0x49, 0xb1, 0x12, 0x20, 0x8b, 0x00, 0x1b, 0xc0, 0xb2, 0x1d, 0x24, 0x58, 0x38,
+	0xbb, 0x4b, 0x79, 0x81, 0x60, 0x2f, 0x02, 0x45, 0x4e, 0x28, 0x26, 0x22, 0xa9, 0xe5, 0x8c, 0x04,
+	0x09, 0x28, 0x50, 0xa0, 0xa7, 0xa2, 0x87, 0xa2, 0x3d, 0xf4, 0xd4, 0x4b, 0x0e, 0x41, 0xfb, 0x07,
+	0xf2, 0x07, 0x72, 0x4b, 0x03, 0x14, 0xc8, 0xb1, 0xe8, 0x21, 0x28, 0x92, 0x4b, 0x7f, 0x43, 0x81,
+	0xa2, 0x05, 0x67, 0x86, 0x34, 0x49, 0x51, 0xb2, 0x8c, 0xf6, 0x64, 0xcd, 0x7b, 0xdf, 0xbc, 0xf7,
+	0xe6, 0xbd, 0x8f, 0x6f, 0xde, 0x18, 0xfe, 0x6a, 0xb8, 0x6a, 0xdf, 0x24, 0xc3, 0x6a, 0x7f, 0xaf,
+	0x6a, 0x61, 0x03, 0x57, 0xba, 0xae, 0x43, 0x1c, 0x11, 0xb8, 0xb8, 0xd2, 0xdf, 0x93, 0x0a, 0x9a,
+	0x83, 0x2d, 0x07, 0x57, 0x5b, 0x2a, 0x46, 0xd5, 0xfe, 0x5e, 0x0b, 0x11, 0x75, 0xaf, 0xaa, 0x39,
+	0xa6, 0xcd, 0xb0, 0x52, 0x9e, 0xe9, 0x9b, 0x74, 0x55, 0x65, 0x0b, 0xae, 0xca, 0x85, 0xac, 0xfb,
+	0x16, 0x99, 0x66, 0xdd, 0x70, 0x0c, 0x87, 0xed, 0xf0, 0x7e, 0x71, 0xe9, 0xa6, 0xe1, 0x38, 0x46,
+	0x07, 0x55, 0xd5, 0xae, 0x59, 0x55, 0x6d, 0xdb, 0x21, 0x2a, 0x31, 0x1d, 0xdb, 0xb7, 0x96, 0xe7,
+	0x5a, 0xba, 0x6a, 0xf5, 0x9e, 0x54, 0x55, 0x9b, 0x9b, 0x93, 0x7f, 0x13, 0x60, 0xf5, 0x18, 0x1b,
+	0x0d, 0x64, 0xeb, 0x27, 0xce, 0x11, 0x69, 0x23, 0x17, 0xf5, 0x2c, 0x71, 0x03, 0xe6, 0x30, 0xb2,
+	0x75, 0xe4, 0xe6, 0x84, 0x2d, 0xa1, 0x34, 0xaf, 0xf0, 0x95, 0x58, 0x06, 0x11, 0x71, 0x4c, 0xd3,
+	0x45, 0x9a, 0xd9, 0x35, 0x91, 0x4d, 0x72, 0x29, 0x8a, 0x59, 0xf5, 0x35, 0x8a, 0xaf, 0x10, 0xef,
+	0xc0, 0x9c, 0x6a, 0x39, 0x3d, 0x9b, 0xe4, 0xd2, 0x5b, 0x42, 0x69, 0xa1, 0x96, 0xaf, 0xf0, 0x43,
+	0x7a, 0x19, 0xa9, 0xf0, 0x8c, 0x54, 0x0e, 0x1c, 0xd3, 0xae, 0x67, 0x5e, 0xbf, 0x2b, 0xce, 0x28,
+	0x1c, 0x2e, 0xde, 0x03, 0x68, 0xb9, 0xa6, 0x6e, 0xa0, 0xe6, 0x13, 0x84, 0x72, 0x99, 0xe9, 0x36,
+	0xcf, 0xb3, 0x2d, 0xf7, 0x11, 0x12, 0x77, 0x61, 0x15, 0x0d, 0xba, 0xa6, 0x8b, 0x70, 0x53, 0x25,
+	0xcd, 0x36, 0x32, 0x8d, 0x36, 0xc9, 0xcd, 0x6e, 0x09, 0xa5, 0x8c, 0xb2, 0xcc, 0x15, 0xfb, 0xe4,
+	0x01, 0x15, 0xcb, 0x37, 0x20, 0x3f, 0x92, 0x00, 0x05, 0xe1, 0xae, 0x63, 0x63, 0x24, 0x66, 0x21,
+	0x65, 0xea, 0x34, 0x09, 0x19, 0x25, 0x65, 0xea, 0xf2, 0xb7, 0x02, 0x6c, 0x8c, 0xa0, 0x8f, 0x7b,
+	0x1d, 0x62, 0x8e, 0xcd, 0xd9, 0x43, 0x80, 0x20, 0x55, 0x38, 0x97, 0xda, 0x4a, 0x97, 0x16, 0x6a,
+	0xdb, 0x95, 0x53, 0x9a, 0x54, 0xe2, 0xae, 0x39, 0x96, 0x9f, 0x2a, 0xb4, 0x39, 0xf9, 0x58, 0xe9,
+	0xe4, 0x63, 0xbd, 0x12, 0xe0, 0xe2, 0x18, 0xcb, 0x63, 0xca, 0x28, 0x9c, 0x5d, 0xc6, 0xd4, 0x1f,
+	0x29, 0x63, 0xfa, 0xbc, 0x65, 0x94, 0x6b, 0x50, 0x48, 0x4e, 0x76, 0x50, 0x9f, 0x15, 0x48, 0x9b,
+	0x3a, 0xce, 0x09, 0x5b, 0xe9, 0x52, 0x46, 0xf1, 0x7e, 0xca, 0xfb, 0x70, 0xf1, 0x18, 0x1b, 0x07,
+	0xaa, 0xad, 0xa1, 0x4e, 0x8c, 0xd5, 0xb1, 0x62, 0x86, 0x2a, 0x96, 0x0a, 0x57, 0x4c, 0xbe, 0x02,
+	0xc5, 0x31, 0x26, 0x7c, 0xbf, 0xf2, 0xe7, 0x02, 0x6c, 0x1e, 0x63, 0xe3, 0xa1, 0xad, 0xb9, 0x48,
+	0xc5, 0x28, 0x8a, 0xf2, 0x18, 0x38, 0xa5, 0x2f, 0xf1, 0x3e, 0x64, 0x55, 0x5d, 0x37, 0xbd, 0xaf,
+	0x55, 0xed, 0x9c, 0x27, 0x4d, 0x4b, 0xa7, 0xdb, 0xbc, 0x54, 0x5d, 0x85, 0xbf, 0x4d, 0x8a, 0x27,
+	0x08, 0xfc, 0x01, 0x14, 0x82, 0xb3, 0xfd, 0x1b, 0xd9, 0xba, 0x69, 0x1b, 0x53, 0x64, 0xc9, 0x34,
+	0xec, 0x50, 0xe4, 0x74, 0x25, 0x97, 0xe0, 0xea, 0x64, 0x4b, 0x81, 0xcf, 0x7d, 0xda, 0x62, 0x14,
+	0xf4, 0xff, 0x1e, 0xc2, 0xa4, 0xae, 0x12, 0xad, 0x7d, 0x32, 0x10, 0xd7, 0x61, 0x56, 0x47, 0xb6,
+	0x63, 0x71, 0xda, 0xb1, 0xc5, 0x58, 0x67, 0x97, 0x20, 0x3f, 0x62, 0x22, 0xb0, 0xff, 0x95, 0x40,
+	0x0b, 0xd6, 0xe8, 0xb5, 0x2c, 0x93, 0xf8, 0xde, 0x4f, 0x06, 0x07, 0x8e, 0xfd, 0xc4, 0x74, 0x2d,
+	0xda, 0x09, 0xc5, 0x13, 0x58, 0xd4, 0x42, 0x6b, 0xea, 0x75, 0xa1, 0xb6, 0x5e, 0x61, 0x9d, 0xb1,
+	0xe2, 0x77, 0xc6, 0xca, 0xbe, 0x3d, 0xac, 0x4b, 0x6f, 0x5e, 0x96, 0x37, 0x92, 0xed, 0x28, 0x11,
+	0x2b, 0xe3, 0xc2, 0xbd, 0x9b, 0xf9, 0xf4, 0x79, 0x71, 0xc6, 0xfb, 0x04, 0xa5, 0x03, 0xc7, 0x26,
+	0xae, 0xaa, 0x91, 0x03, 0xb5, 0xd3, 0x89, 0x85, 0x54, 0x06, 0xd1, 0xb4, 0xfb, 0x6a, 0xc7, 0xd4,
+	0xe9, 0xba, 0x89, 0x35, 0xa7, 0x8b, 0x68, 0x60, 0x8b, 0xca, 0x6a, 0x58, 0xd3, 0xf0, 0x14, 0x23,
+	0x70, 0xdb, 0xb1, 0x35, 0x44, 0xfd, 0x66, 0xa2, 0xf0, 0x47, 0x9e, 0x42, 0xbc, 0x06, 0xcb, 0xc1,
+	0x37, 0xce, 0x63, 0x4c, 0xd3, 0x18, 0xb3, 0xbe, 0xb8, 0x41, 0xa5, 0xe2, 0x26, 0xcc, 0x7b, 0x7a,
+	0x95, 0xf4, 0x5c, 0xd6, 0x6a, 0x17, 0x95, 0x53, 0x81, 0xfc, 0x42, 0x80, 0x35, 0x9e, 0xef, 0x48,
+	0xf0, 0x3b, 0x90, 0x25, 0xce, 0x33, 0x64, 0x37, 0x35, 0x7e, 0x40, 0x5e, 0xc7, 0x25, 0x2a, 0xf5,
+	0x4f, 0x2d, 0x16, 0x61, 0xa1, 0xe5, 0xed, 0x8e, 0x44, 0x0b, 0x54, 0xf4, 0xa7, 0x86, 0xf9, 0x99,
+	0xd7, 0xed, 0x28, 0xb0, 0x81, 0x48, 0x2c, 0xd4, 0x12, 0xac, 0x30, 0xcb, 0x4d, 0x8c, 0x08, 0x0f,
+	0x84, 0xd1, 0x3b, 0x8b, 0xfd, 0x2d, 0x63, 0x83, 0x49, 0x9d, 0x1d, 0x4c, 0x3a, 0x1e, 0xcc, 0x75,
+	0xb8, 0x76, 0x06, 0x1d, 0x03, 0xea, 0xf6, 0x60, 0x63, 0x04, 0x7a, 0xd4, 0xf7, 0x9a, 0xee, 0x3f,
+	0x60, 0x16, 0xf5, 0xfd, 0xb6, 0x3c, 0x8e, 0xa9, 0xab, 0x6f, 0x5e, 0x96, 0x97, 0x22, 0xfb, 0x14,
+	0xb6, 0xeb, 0x0c, 0x66, 0x6e, 0x41, 0x21, 0xd9, 0x6d, 0x10, 0xd8, 0x2b, 0x01, 0x96, 0x8f, 0xb1,
+	0x71, 0x88, 0x3a, 0xc8, 0x50, 0x09, 0xfa, 0x27, 0x1a, 0x62, 0xf1, 0x06, 0xac, 0x72, 0x96, 0x39,
+	0x6e, 0x53, 0xd5, 0x75, 0x17, 0x61, 0xcc, 0xcb, 0xbe, 0x12, 0x28, 0xf6, 0x99, 0x5c, 0xdc, 0x83,
+	0x75, 0xc7, 0xd5, 0xda, 0x08, 0x13, 0x37, 0x82, 0x67, 0xe1, 0xac, 0x85, 0x75, 0xfe, 0x96, 0xeb,
+	0xb0, 0x12, 0xa4, 0xdf, 0x87, 0x33, 0x32, 0x04, 0x65, 0xf1, 0xa1, 0xdb, 0xb0, 0x84, 0x48, 0xbb,
+	0x19, 0x67, 0xc4, 0x22, 0x22, 0xed, 0x46, 0x50, 0x87, 0x3c, 0x5c, 0x8c, 0x1d, 0x21, 0x38, 0xde,
+	0xd7, 0x02, 0x5c, 0x0e, 0x32, 0x50, 0x57, 0xf5, 0x60, 0xd3, 0x51, 0xdf, 0xd4, 0x91, 0xc7, 0x85,
+	0x7b, 0x70, 0x01, 0xf7, 0x5a, 0x4f, 0x91, 0x36, 0xb9, 0x02, 0xd9, 0x37, 0x2f, 0xcb, 0xf0, 0xaf,
+	0x1e, 0x31, 0x1c, 0xd3, 0x36, 0x4e, 0x06, 0x8a, 0xbf, 0x29, 0x4a, 0x91, 0x54, 0x8c, 0x22, 0xa1,
+	0xf2, 0xa4, 0x13, 0xca, 0x73, 0x0d, 0x76, 0x26, 0x06, 0x17, 0x1c, 0xe3, 0x31, 0xac, 0x85, 0x8f,
+	0xe7, 0x01, 0x8f, 0xb1, 0x71, 0xbe, 0x42, 0xad, 0xc3, 0x6c, 0xf8, 0xe3, 0x64, 0x0b, 0xf9, 0x45,
+	0x0a, 0x56, 0x59, 0x3b, 0x3f, 0xa0, 0xf7, 0x10, 0x23, 0x65, 0x11, 0x16, 0x28, 0xbd, 0x22, 0x5f,
+	0x11, 0x50, 0x11, 0xfb, 0x82, 0x46, 0xdb, 0x42, 0x2a, 0xa9, 0x2d, 0xdc, 0x8f, 0x0c, 0x86, 0xf3,
+	0xf5, 0x8a, 0x77, 0xa5, 0xfd, 0xf8, 0xae, 0x78, 0xd5, 0x30, 0x49, 0xbb, 0xd7, 0xaa, 0x68, 0x8e,
+	0xc5, 0xe7, 0x61, 0xfe, 0xa7, 0x8c, 0xf5, 0x67, 0x55, 0x32, 0xec, 0x22, 0x5c, 0x79, 0x68, 0x93,
+	0x60, 0xc0, 0x88, 0x7c, 0xb0, 0xec, 0x7a, 0xcd, 0xc4, 0x3e, 0x58, 0x2a, 0xf5, 0x80, 0x7c, 0xd8,
+	0x76, 0x91, 0x86, 0xcc, 0x3e, 0x72, 0xe9, 0x38, 0x38, 0xaf, 0x64, 0x99, 0x58, 0xe1, 0xd2, 0x88,
+	0x45, 0x3e, 0x60, 0xcd, 0xb1, 0x5e, 0xe1, 0x8b, 0xd9, 0x7c, 0x75, 0x37, 0xf3, 0xf3, 0xf3, 0xa2,
+	0x20, 0x7f, 0x23, 0x80, 0x48, 0xdb, 0xe3, 0xd1, 0x00, 0x69, 0x3d, 0x82, 0x74, 0x96, 0xa7, 0xe9,
+	0xbb, 0x63, 0x38, 0x9d, 0xa9, 0x91, 0x74, 0x26, 0x44, 0x93, 0x4e, 0x8a, 0x26, 0xde, 0x67, 0x33,
+	0xf1, 0x3e, 0x2b, 0xff, 0x2a, 0x40, 0x3e, 0x7c, 0x17, 0x45, 0xe3, 0x3d, 0xb3, 0xae, 0x46, 0xe2,
+	0x5d, 0x45, 0x69, 0x5d, 0xff, 0xfb, 0x2f, 0xef, 0x8a, 0xb7, 0x43, 0x85, 0x23, 0x34, 0xe5, 0x96,
+	0x69, 0x93, 0xf0, 0xcf, 0x8e, 0xd9, 0xc2, 0xd5, 0xd6, 0x90, 0x20, 0x5c, 0x79, 0x80, 0x06, 0x75,
+	0xef, 0xc7, 0xf4, 0xb7, 0x5c, 0x7a, 0x9a, 0x5b, 0x8e, 0x27, 0x28, 0x93, 0x94, 0x20, 0xf9, 0xcb,
+	0x14, 0x88, 0x47, 0xca, 0x41, 0xed, 0xe6, 0x21, 0xea, 0x76, 0x9c, 0xe1, 0xd4, 0x07, 0xbf, 0x02,
+	0x8b, 0x8c, 0x21, 0x4d, 0x36, 0xad, 0x30, 0x3a, 0x2f, 0x30, 0xd9, 0xa1, 0x27, 0x4a, 0x28, 0x76,
+	0x3a, 0xa9, 0xd8, 0x97, 0x01, 0x90, 0xab, 0xd5, 0x6e, 0x36, 0x6d, 0xd5, 0x42, 0x9c, 0xa6, 0xf3,
+	0x54, 0xf2, 0x48, 0xb5, 0xa8, 0x23, 0xa6, 0xc6, 0x43, 0xab, 0xe5, 0x74, 0x38, 0x3d, 0x17, 0xa8,
+	0xac, 0x41, 0x45, 0x9e, 0x23, 0x06, 0xd1, 0x91, 0x66, 0x5a, 0x6a, 0x07, 0x73, 0x6a, 0x2e, 0x51,
+	0xe9, 0x21, 0x17, 0x26, 0xe5, 0xe4, 0x42, 0x62, 0x4e, 0xbe, 0x13, 0x20, 0x17, 0xba, 0x34, 0xcf,
+	0x49, 0x89, 0x32, 0xac, 0x85, 0xae, 0x55, 0x32, 0x88, 0x90, 0x78, 0x05, 0x9f, 0xda, 0x3d, 0x27,
+	0x95, 0x6f, 0xc3, 0x05, 0x0b, 0x59, 0x2d, 0xe4, 0xe2, 0x5c, 0x86, 0x3e, 0x96, 0xa4, 0xf0, 0x63,
+	0xe9, 0x28, 0x72, 0x11, 0x2b, 0x3e, 0xb4, 0xf6, 0xfd, 0x5f, 0x20, 0xed, 0xb5, 0xbe, 0xc7, 0x90,
+	0x8d, 0xcd, 0xb3, 0x97, 0xc3, 0xdb, 0x47, 0x9e, 0x13, 0xd2, 0xce, 0x44, 0x75, 0xd0, 0x69, 0x67,
+	0x44, 0x03, 0xd6, 0x92, 0x9e, 0x7d, 0xf2, 0xc4, 0xfd, 0x14, 0x23, 0xed, 0x9e, 0x8d, 0x09, 0x39,
+	0x7a, 0x0a, 0xeb, 0x89, 0xcf, 0x97, 0xed, 0x98, 0x95, 0x24, 0x90, 0x74, 0x63, 0x0a, 0x50, 0xc8,
+	0xd7, 0x10, 0xf2, 0xe3, 0xdf, 0x30, 0xa5, 0x98, 0xad, 0xb1, 0x48, 0xe9, 0xe6, 0xb4, 0xc8, 0x90,
+	0xeb, 0xc7, 0x90, 0x8d, 0x3d, 0x09, 0xe2, 0x95, 0x8a, 0xaa, 0xa5, 0x9d, 0x89, 0xea, 0x90, 0xe5,
+	0x4f, 0x04, 0xd8, 0x9c, 0xf8, 0x18, 0x88, 0x27, 0x69, 0x12, 0x58, 0xba, 0x75, 0x0e, 0x70, 0x8c,
+	0x2e, 0x09, 0x63, 0x9d, 0x3c, 0xd1, 0x1a, 0xc5, 0x48, 0xbb, 0x67, 0x63, 0x42, 0x8e, 0xfe, 0x0b,
+	0xcb, 0x0d, 0x44, 0x22, 0x83, 0xda, 0xa5, 0x98, 0x81, 0xb0, 0x52, 0xda, 0x9e, 0xa0, 0x0c, 0x99,
+	0xfd, 0x08, 0xa4, 0x09, 0xd3, 0xd1, 0xf5, 0xc4, 0x10, 0x93, 0xa0, 0xd2, 0xde, 0xd4, 0xd0, 0x90,
+	0xf7, 0x8f, 0xe1, 0xd2, 0xa4, 0x37, 0xea, 0x6e, 0x22, 0xcb, 0x13, 0xb1, 0x52, 0x6d, 0x7a, 0xec,
+	0x69, 0x00, 0xf5, 0xff, 0xbc, 0x7e, 0x5f, 0x10, 0xde, 0xbe, 0x2f, 0x08, 0x3f, 0xbd, 0x2f, 0x08,
+	0x5f, 0x7c, 0x28, 0xcc, 0xbc, 0xfd, 0x50, 0x98, 0xf9, 0xe1, 0x43, 0x61, 0xe6, 0x7f, 0x77, 0x46,
+	0x67, 0x14, 0xee, 0xa0, 0xcc, 0xfe, 0x73, 0x51, 0xb5, 0x1c, 0xbd, 0xd7, 0x41, 0xd5, 0x81, 0x2f,
+	0x67, 0x83, 0x4b, 0x6b, 0x8e, 0x0e, 0x8e, 0xb7, 0x7e, 0x1f, 0x00, 0xaa, 0xef, 0x95, 0x56, 0x3a,
+	0x14, 0x00, 0x00,
 }
 
 func (this *SendToCosmosEvent) Equal(that interface{}) bool {
@@ -1398,11 +1878,15 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	SendToEthereum(ctx context.Context, in *MsgSendToEthereum, opts ...grpc.CallOption) (*MsgSendToEthereumResponse, error)
+	SendToEthereumMulti(ctx context.Context, in *MsgSendToEthereumMulti, opts ...grpc.CallOption) (*MsgSendToEthereumMultiResponse, error)
 	CancelSendToEthereum(ctx context.Context, in *MsgCancelSendToEthereum, opts ...grpc.CallOption) (*MsgCancelSendToEthereumResponse, error)
+	IncreaseSendToEthereumFee(ctx context.Context, in *MsgIncreaseSendToEthereumFee, opts ...grpc.CallOption) (*MsgIncreaseSendToEthereumFeeResponse, error)
 	RequestBatchTx(ctx context.Context, in *MsgRequestBatchTx, opts ...grpc.CallOption) (*MsgRequestBatchTxResponse, error)
 	SubmitEthereumTxConfirmation(ctx context.Context, in *MsgSubmitEthereumTxConfirmation, opts ...grpc.CallOption) (*MsgSubmitEthereumTxConfirmationResponse, error)
 	SubmitEthereumEvent(ctx context.Context, in *MsgSubmitEthereumEvent, opts ...grpc.CallOption) (*MsgSubmitEthereumEventResponse, error)
 	SetDelegateKeys(ctx context.Context, in *MsgDelegateKeys, opts ...grpc.CallOption) (*MsgDelegateKeysResponse, error)
+	SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error)
+	CancelPendingSendToEthereum(ctx context.Context, in *MsgCancelPendingSendToEthereum, opts ...grpc.CallOption) (*MsgCancelPendingSendToEthereumResponse, error)
 }
 
 type msgClient struct {
@@ -1422,6 +1906,15 @@ func (c *msgClient) SendToEthereum(ctx context.Context, in *MsgSendToEthereum, o
 	return out, nil
 }
 
+func (c *msgClient) SendToEthereumMulti(ctx context.Context, in *MsgSendToEthereumMulti, opts ...grpc.CallOption) (*MsgSendToEthereumMultiResponse, error) {
+	out := new(MsgSendToEthereumMultiResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SendToEthereumMulti", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CancelSendToEthereum(ctx context.Context, in *MsgCancelSendToEthereum, opts ...grpc.CallOption) (*MsgCancelSendToEthereumResponse, error) {
 	out := new(MsgCancelSendToEthereumResponse)
 	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/CancelSendToEthereum", in, out, opts...)
@@ -1431,6 +1924,15 @@ func (c *msgClient) CancelSendToEthereum(ctx context.Context, in *MsgCancelSendT
 	return out, nil
 }
 
+func (c *msgClient) IncreaseSendToEthereumFee(ctx context.Context, in *MsgIncreaseSendToEthereumFee, opts ...grpc.CallOption) (*MsgIncreaseSendToEthereumFeeResponse, error) {
+	out := new(MsgIncreaseSendToEthereumFeeResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/IncreaseSendToEthereumFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) RequestBatchTx(ctx context.Context, in *MsgRequestBatchTx, opts ...grpc.CallOption) (*MsgRequestBatchTxResponse, error) {
 	out := new(MsgRequestBatchTxResponse)
 	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/RequestBatchTx", in, out, opts...)
@@ -1467,14 +1969,36 @@ func (c *msgClient) SetDelegateKeys(ctx context.Context, in *MsgDelegateKeys, op
 	return out, nil
 }
 
+func (c *msgClient) SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error) {
+	out := new(MsgSubmitBadSignatureEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitBadSignatureEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelPendingSendToEthereum(ctx context.Context, in *MsgCancelPendingSendToEthereum, opts ...grpc.CallOption) (*MsgCancelPendingSendToEthereumResponse, error) {
+	out := new(MsgCancelPendingSendToEthereumResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/CancelPendingSendToEthereum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	SendToEthereum(context.Context, *MsgSendToEthereum) (*MsgSendToEthereumResponse, error)
+	SendToEthereumMulti(context.Context, *MsgSendToEthereumMulti) (*MsgSendToEthereumMultiResponse, error)
 	CancelSendToEthereum(context.Context, *MsgCancelSendToEthereum) (*MsgCancelSendToEthereumResponse, error)
+	IncreaseSendToEthereumFee(context.Context, *MsgIncreaseSendToEthereumFee) (*MsgIncreaseSendToEthereumFeeResponse, error)
 	RequestBatchTx(context.Context, *MsgRequestBatchTx) (*MsgRequestBatchTxResponse, error)
 	SubmitEthereumTxConfirmation(context.Context, *MsgSubmitEthereumTxConfirmation) (*MsgSubmitEthereumTxConfirmationResponse, error)
 	SubmitEthereumEvent(context.Context, *MsgSubmitEthereumEvent) (*MsgSubmitEthereumEventResponse, error)
 	SetDelegateKeys(context.Context, *MsgDelegateKeys) (*MsgDelegateKeysResponse, error)
+	SubmitBadSignatureEvidence(context.Context, *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error)
+	CancelPendingSendToEthereum(context.Context, *MsgCancelPendingSendToEthereum) (*MsgCancelPendingSendToEthereumResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1484,9 +2008,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) SendToEthereum(ctx context.Context, req *MsgSendToEthereum) (*MsgSendToEthereumResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendToEthereum not implemented")
 }
+func (*UnimplementedMsgServer) SendToEthereumMulti(ctx context.Context, req *MsgSendToEthereumMulti) (*MsgSendToEthereumMultiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendToEthereumMulti not implemented")
+}
 func (*UnimplementedMsgServer) CancelSendToEthereum(ctx context.Context, req *MsgCancelSendToEthereum) (*MsgCancelSendToEthereumResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSendToEthereum not implemented")
 }
+func (*UnimplementedMsgServer) IncreaseSendToEthereumFee(ctx context.Context, req *MsgIncreaseSendToEthereumFee) (*MsgIncreaseSendToEthereumFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncreaseSendToEthereumFee not implemented")
+}
 func (*UnimplementedMsgServer) RequestBatchTx(ctx context.Context, req *MsgRequestBatchTx) (*MsgRequestBatchTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestBatchTx not implemented")
 }
@@ -1499,6 +2029,12 @@ func (*UnimplementedMsgServer) SubmitEthereumEvent(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) SetDelegateKeys(ctx context.Context, req *MsgDelegateKeys) (*MsgDelegateKeysResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetDelegateKeys not implemented")
 }
+func (*UnimplementedMsgServer) SubmitBadSignatureEvidence(ctx context.Context, req *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBadSignatureEvidence not implemented")
+}
+func (*UnimplementedMsgServer) CancelPendingSendToEthereum(ctx context.Context, req *MsgCancelPendingSendToEthereum) (*MsgCancelPendingSendToEthereumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPendingSendToEthereum not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1522,6 +2058,24 @@ func _Msg_SendToEthereum_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SendToEthereumMulti_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendToEthereumMulti)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendToEthereumMulti(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SendToEthereumMulti",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendToEthereumMulti(ctx, req.(*MsgSendToEthereumMulti))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CancelSendToEthereum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCancelSendToEthereum)
 	if err := dec(in); err != nil {
@@ -1540,6 +2094,24 @@ func _Msg_CancelSendToEthereum_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_IncreaseSendToEthereumFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIncreaseSendToEthereumFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IncreaseSendToEthereumFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/IncreaseSendToEthereumFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IncreaseSendToEthereumFee(ctx, req.(*MsgIncreaseSendToEthereumFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_RequestBatchTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRequestBatchTx)
 	if err := dec(in); err != nil {
@@ -1612,6 +2184,42 @@ func _Msg_SetDelegateKeys_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitBadSignatureEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitBadSignatureEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitBadSignatureEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SubmitBadSignatureEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitBadSignatureEvidence(ctx, req.(*MsgSubmitBadSignatureEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelPendingSendToEthereum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPendingSendToEthereum)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPendingSendToEthereum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/CancelPendingSendToEthereum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPendingSendToEthereum(ctx, req.(*MsgCancelPendingSendToEthereum))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gravity.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1620,10 +2228,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendToEthereum",
 			Handler:    _Msg_SendToEthereum_Handler,
 		},
+		{
+			MethodName: "SendToEthereumMulti",
+			Handler:    _Msg_SendToEthereumMulti_Handler,
+		},
 		{
 			MethodName: "CancelSendToEthereum",
 			Handler:    _Msg_CancelSendToEthereum_Handler,
 		},
+		{
+			MethodName: "IncreaseSendToEthereumFee",
+			Handler:    _Msg_IncreaseSendToEthereumFee_Handler,
+		},
 		{
 			MethodName: "RequestBatchTx",
 			Handler:    _Msg_RequestBatchTx_Handler,
@@ -1640,6 +2256,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetDelegateKeys",
 			Handler:    _Msg_SetDelegateKeys_Handler,
 		},
+		{
+			MethodName: "SubmitBadSignatureEvidence",
+			Handler:    _Msg_SubmitBadSignatureEvidence_Handler,
+		},
+		{
+			MethodName: "CancelPendingSendToEthereum",
+			Handler:    _Msg_CancelPendingSendToEthereum_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gravity/v1/msgs.proto",
@@ -1665,6 +2289,11 @@ func (m *MsgSendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1730,7 +2359,7 @@ func (m *MsgSendToEthereumResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelSendToEthereum) Marshal() (dAtA []byte, err error) {
+func (m *MsgSendToEthereumMulti) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1740,32 +2369,46 @@ func (m *MsgCancelSendToEthereum) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelSendToEthereum) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSendToEthereumMulti) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelSendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSendToEthereumMulti) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
 		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelSendToEthereumResponse) Marshal() (dAtA []byte, err error) {
+func (m *SendToEthereumRecipient) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1775,20 +2418,47 @@ func (m *MsgCancelSendToEthereumResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelSendToEthereumResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SendToEthereumRecipient) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelSendToEthereumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SendToEthereumRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.EthereumRecipient) > 0 {
+		i -= len(m.EthereumRecipient)
+		copy(dAtA[i:], m.EthereumRecipient)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumRecipient)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestBatchTx) Marshal() (dAtA []byte, err error) {
+func (m *MsgSendToEthereumMultiResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1798,34 +2468,38 @@ func (m *MsgRequestBatchTx) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRequestBatchTx) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSendToEthereumMultiResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRequestBatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSendToEthereumMultiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Denom)))
+	if len(m.Ids) > 0 {
+		dAtA6 := make([]byte, len(m.Ids)*10)
+		var j5 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintMsgs(dAtA, i, uint64(j5))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestBatchTxResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelSendToEthereum) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1835,20 +2509,32 @@ func (m *MsgRequestBatchTxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRequestBatchTxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelSendToEthereum) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRequestBatchTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelSendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitEthereumTxConfirmation) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelSendToEthereumResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1858,39 +2544,20 @@ func (m *MsgSubmitEthereumTxConfirmation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitEthereumTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelSendToEthereumResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitEthereumTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelSendToEthereumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Confirmation != nil {
-		{
-			size, err := m.Confirmation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMsgs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractCallTxConfirmation) Marshal() (dAtA []byte, err error) {
+func (m *MsgIncreaseSendToEthereumFee) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1900,20 +2567,248 @@ func (m *ContractCallTxConfirmation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractCallTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgIncreaseSendToEthereumFee) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractCallTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgIncreaseSendToEthereumFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+	{
+		size, err := m.AdditionalFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIncreaseSendToEthereumFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIncreaseSendToEthereumFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIncreaseSendToEthereumFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPendingSendToEthereum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPendingSendToEthereum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPendingSendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPendingSendToEthereumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPendingSendToEthereumResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPendingSendToEthereumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestBatchTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestBatchTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestBatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestBatchTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestBatchTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestBatchTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitEthereumTxConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEthereumTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEthereumTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Confirmation != nil {
+		{
+			size, err := m.Confirmation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTxConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -2192,7 +3087,7 @@ func (m *MsgDelegateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegateKeysSignMsg) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitBadSignatureEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2202,32 +3097,46 @@ func (m *DelegateKeysSignMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegateKeysSignMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitBadSignatureEvidence) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegateKeysSignMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitBadSignatureEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Subject != nil {
+		{
+			size, err := m.Subject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SendToCosmosEvent) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitBadSignatureEvidenceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2237,18 +3146,76 @@ func (m *SendToCosmosEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SendToCosmosEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitBadSignatureEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SendToCosmosEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitBadSignatureEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EthereumHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysSignMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysSignMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysSignMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendToCosmosEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendToCosmosEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendToCosmosEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthereumHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
 		i--
 		dAtA[i] = 0x30
 	}
@@ -2528,6 +3495,9 @@ func (m *MsgSendToEthereum) Size() (n int) {
 	n += 1 + l + sovMsgs(uint64(l))
 	l = m.BridgeFee.Size()
 	n += 1 + l + sovMsgs(uint64(l))
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.ExpiresAtHeight))
+	}
 	return n
 }
 
@@ -2543,6 +3513,61 @@ func (m *MsgSendToEthereumResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSendToEthereumMulti) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.ExpiresAtHeight))
+	}
+	return n
+}
+
+func (m *SendToEthereumRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EthereumRecipient)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgSendToEthereumMultiResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovMsgs(uint64(e))
+		}
+		n += 1 + sovMsgs(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *MsgCancelSendToEthereum) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2568,6 +3593,58 @@ func (m *MsgCancelSendToEthereumResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgIncreaseSendToEthereumFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMsgs(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.AdditionalFee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgIncreaseSendToEthereumFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelPendingSendToEthereum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMsgs(uint64(m.Id))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelPendingSendToEthereumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgRequestBatchTx) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2748,6 +3825,36 @@ func (m *MsgDelegateKeysResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSubmitBadSignatureEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Subject != nil {
+		l = m.Subject.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitBadSignatureEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *DelegateKeysSignMsg) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2992,13 +4099,817 @@ func (m *MsgSendToEthereum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumRecipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.EthereumRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendToEthereumResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendToEthereumResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendToEthereumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendToEthereumMulti) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendToEthereumMulti: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendToEthereumMulti: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, SendToEthereumRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendToEthereumRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendToEthereumRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendToEthereumRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendToEthereumMultiResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendToEthereumMultiResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendToEthereumMultiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMsgs
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMsgs
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMsgs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSendToEthereum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSendToEthereum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSendToEthereumResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSendToEthereumResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSendToEthereumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIncreaseSendToEthereumFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIncreaseSendToEthereumFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIncreaseSendToEthereumFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3008,28 +4919,27 @@ func (m *MsgSendToEthereum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3056,7 +4966,7 @@ func (m *MsgSendToEthereum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AdditionalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3084,7 +4994,7 @@ func (m *MsgSendToEthereum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendToEthereumResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgIncreaseSendToEthereumFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3107,31 +5017,12 @@ func (m *MsgSendToEthereumResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendToEthereumResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgIncreaseSendToEthereumFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendToEthereumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgIncreaseSendToEthereumFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -3156,7 +5047,7 @@ func (m *MsgSendToEthereumResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelSendToEthereum) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPendingSendToEthereum) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3179,10 +5070,10 @@ func (m *MsgCancelSendToEthereum) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelSendToEthereum: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPendingSendToEthereum: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelSendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPendingSendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3206,7 +5097,7 @@ func (m *MsgCancelSendToEthereum) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3234,7 +5125,7 @@ func (m *MsgCancelSendToEthereum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3260,7 +5151,7 @@ func (m *MsgCancelSendToEthereum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelSendToEthereumResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPendingSendToEthereumResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3283,10 +5174,10 @@ func (m *MsgCancelSendToEthereumResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelSendToEthereumResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPendingSendToEthereumResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelSendToEthereumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPendingSendToEthereumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4547,6 +6438,214 @@ func (m *MsgDelegateKeysResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Subject == nil {
+				m.Subject = &types1.Any{}
+			}
+			if err := m.Subject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitBadSignatureEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DelegateKeysSignMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -78,3 +78,62 @@ func TestValidateMsgDelegateKeys(t *testing.T) {
 	}
 
 }
+
+func TestValidateMsgSendToEthereumMulti(t *testing.T) {
+	var (
+		sender    sdk.AccAddress = bytes.Repeat([]byte{0x1}, 20)
+		recipient                = types.SendToEthereumRecipient{
+			EthereumRecipient: "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255",
+			Amount:            sdk.NewInt64Coin("ugrav", 100),
+			BridgeFee:         sdk.NewInt64Coin("ugrav", 1),
+		}
+	)
+	specs := map[string]struct {
+		modify func(*types.MsgSendToEthereumMulti)
+		expErr bool
+	}{
+		"all good": {
+			modify: func(*types.MsgSendToEthereumMulti) {},
+		},
+		"invalid sender": {
+			modify: func(msg *types.MsgSendToEthereumMulti) { msg.Sender = "invalid" },
+			expErr: true,
+		},
+		"no recipients": {
+			modify: func(msg *types.MsgSendToEthereumMulti) { msg.Recipients = nil },
+			expErr: true,
+		},
+		"invalid ethereum recipient": {
+			modify: func(msg *types.MsgSendToEthereumMulti) { msg.Recipients[1].EthereumRecipient = "invalid" },
+			expErr: true,
+		},
+		"zero amount": {
+			modify: func(msg *types.MsgSendToEthereumMulti) { msg.Recipients[1].Amount = sdk.NewInt64Coin("ugrav", 0) },
+			expErr: true,
+		},
+		"fee of another denom": {
+			modify: func(msg *types.MsgSendToEthereumMulti) { msg.Recipients[1].BridgeFee = sdk.NewInt64Coin("stake", 1) },
+			expErr: true,
+		},
+		"recipients of another denom": {
+			modify: func(msg *types.MsgSendToEthereumMulti) {
+				msg.Recipients[1].Amount = sdk.NewInt64Coin("stake", 100)
+				msg.Recipients[1].BridgeFee = sdk.NewInt64Coin("stake", 1)
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			msg := types.NewMsgSendToEthereumMulti(sender, []types.SendToEthereumRecipient{recipient, recipient})
+			spec.modify(msg)
+			err := msg.ValidateBasic()
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
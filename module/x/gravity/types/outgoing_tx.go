@@ -56,6 +56,67 @@ func (cctx *ContractCallTx) GetCosmosHeight() uint64 {
 	return cctx.Height
 }
 
+//////////////
+// Validate //
+//////////////
+
+func (sstx *SignerSetTx) Validate() error {
+	if sstx.Nonce == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "nonce must be set")
+	}
+	for _, signer := range sstx.Signers {
+		if !gethcommon.IsHexAddress(signer.EthereumAddress) {
+			return sdkerrors.Wrapf(ErrInvalid, "signer ethereum address %s", signer.EthereumAddress)
+		}
+	}
+	return nil
+}
+
+func (btx *BatchTx) Validate() error {
+	if btx.BatchNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "nonce must be set")
+	}
+	if !gethcommon.IsHexAddress(btx.TokenContract) {
+		return sdkerrors.Wrapf(ErrInvalid, "token contract %s", btx.TokenContract)
+	}
+	for _, ste := range btx.Transactions {
+		if err := ste.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "send to ethereum %d", ste.Id)
+		}
+		if gethcommon.HexToAddress(ste.Erc20Token.Contract) != gethcommon.HexToAddress(btx.TokenContract) {
+			return sdkerrors.Wrapf(ErrInvalid, "send to ethereum %d is not for token contract %s", ste.Id, btx.TokenContract)
+		}
+	}
+	return nil
+}
+
+func (cctx *ContractCallTx) Validate() error {
+	if cctx.InvalidationNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "invalidation nonce must be set")
+	}
+	if len(cctx.InvalidationScope) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "invalidation scope must be set")
+	}
+	if !gethcommon.IsHexAddress(cctx.Address) {
+		return sdkerrors.Wrapf(ErrInvalid, "contract address %s", cctx.Address)
+	}
+	return nil
+}
+
+// Validate performs stateless checks on a send to ethereum
+func (ste *SendToEthereum) Validate() error {
+	if ste.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "id must be set")
+	}
+	if !gethcommon.IsHexAddress(ste.EthereumRecipient) {
+		return sdkerrors.Wrapf(ErrInvalid, "ethereum recipient %s", ste.EthereumRecipient)
+	}
+	if !gethcommon.IsHexAddress(ste.Erc20Token.Contract) || !gethcommon.IsHexAddress(ste.Erc20Fee.Contract) {
+		return sdkerrors.Wrap(ErrInvalid, "token contract must be valid ethereum address")
+	}
+	return nil
+}
+
 ///////////////////
 // GetCheckpoint //
 ///////////////////
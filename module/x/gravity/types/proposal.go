@@ -0,0 +1,167 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeResumeBridge defines the type for a ResumeBridgeProposal
+	ProposalTypeResumeBridge = "ResumeBridge"
+
+	// ProposalTypeBridgePause defines the type for a BridgePauseProposal
+	ProposalTypeBridgePause = "BridgePause"
+
+	// ProposalTypeCancelPendingSendToEthereum defines the type for a CancelPendingSendToEthereumProposal
+	ProposalTypeCancelPendingSendToEthereum = "CancelPendingSendToEthereum"
+)
+
+var (
+	_ govtypes.Content = &ResumeBridgeProposal{}
+	_ govtypes.Content = &BridgePauseProposal{}
+	_ govtypes.Content = &CancelPendingSendToEthereumProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeResumeBridge)
+	govtypes.RegisterProposalTypeCodec(&ResumeBridgeProposal{}, "gravity/ResumeBridgeProposal")
+	govtypes.RegisterProposalType(ProposalTypeBridgePause)
+	govtypes.RegisterProposalTypeCodec(&BridgePauseProposal{}, "gravity/BridgePauseProposal")
+	govtypes.RegisterProposalType(ProposalTypeCancelPendingSendToEthereum)
+	govtypes.RegisterProposalTypeCodec(&CancelPendingSendToEthereumProposal{}, "gravity/CancelPendingSendToEthereumProposal")
+}
+
+// NewResumeBridgeProposal creates a new bridge resume proposal
+func NewResumeBridgeProposal(title, description string) *ResumeBridgeProposal {
+	return &ResumeBridgeProposal{Title: title, Description: description}
+}
+
+// ProposalRoute returns the routing key of a bridge resume proposal
+func (p *ResumeBridgeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a bridge resume proposal
+func (p *ResumeBridgeProposal) ProposalType() string { return ProposalTypeResumeBridge }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ResumeBridgeProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(p)
+}
+
+// String implements the Stringer interface
+func (p ResumeBridgeProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Resume Bridge Proposal:
+  Title:       %s
+  Description: %s
+`, p.Title, p.Description))
+	return b.String()
+}
+
+// NewBridgePauseProposal creates a new bridge pause proposal. The bridge is paused for the tokens, or for
+// every token if global is set, when paused is true and resumed otherwise.
+func NewBridgePauseProposal(title, description string, paused, global bool, tokens []string) *BridgePauseProposal {
+	return &BridgePauseProposal{
+		Title:       title,
+		Description: description,
+		Paused:      paused,
+		Global:      global,
+		Tokens:      tokens,
+	}
+}
+
+// ProposalRoute returns the routing key of a bridge pause proposal
+func (p *BridgePauseProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a bridge pause proposal
+func (p *BridgePauseProposal) ProposalType() string { return ProposalTypeBridgePause }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *BridgePauseProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if p.Global && len(p.Tokens) > 0 {
+		return sdkerrors.Wrap(ErrInvalid, "a global pause proposal can't list tokens")
+	}
+	if !p.Global && len(p.Tokens) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "no tokens to pause")
+	}
+
+	seen := make(map[string]bool, len(p.Tokens))
+	for _, token := range p.Tokens {
+		if strings.TrimSpace(token) == "" {
+			return sdkerrors.Wrap(ErrInvalid, "empty token")
+		}
+		if seen[token] {
+			return sdkerrors.Wrapf(ErrInvalid, "duplicate token %s", token)
+		}
+		seen[token] = true
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (p BridgePauseProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Bridge Pause Proposal:
+  Title:       %s
+  Description: %s
+  Paused:      %t
+  Global:      %t
+  Tokens:      %s
+`, p.Title, p.Description, p.Paused, p.Global, strings.Join(p.Tokens, ", ")))
+	return b.String()
+}
+
+// NewCancelPendingSendToEthereumProposal creates a new proposal to cancel held sends to ethereum
+func NewCancelPendingSendToEthereumProposal(title, description string, ids []uint64) *CancelPendingSendToEthereumProposal {
+	return &CancelPendingSendToEthereumProposal{Title: title, Description: description, Ids: ids}
+}
+
+// ProposalRoute returns the routing key of a pending send to ethereum cancel proposal
+func (p *CancelPendingSendToEthereumProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a pending send to ethereum cancel proposal
+func (p *CancelPendingSendToEthereumProposal) ProposalType() string {
+	return ProposalTypeCancelPendingSendToEthereum
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *CancelPendingSendToEthereumProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if len(p.Ids) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "no send to ethereum ids")
+	}
+
+	seen := make(map[uint64]bool, len(p.Ids))
+	for _, id := range p.Ids {
+		if id == 0 {
+			return sdkerrors.Wrap(ErrInvalid, "id cannot be 0")
+		}
+		if seen[id] {
+			return sdkerrors.Wrapf(ErrInvalid, "duplicate id %d", id)
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (p CancelPendingSendToEthereumProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Cancel Pending Send To Ethereum Proposal:
+  Title:       %s
+  Description: %s
+  Ids:         %v
+`, p.Title, p.Description, p.Ids))
+	return b.String()
+}
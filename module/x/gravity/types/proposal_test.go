@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+func TestValidateBridgePauseProposal(t *testing.T) {
+	specs := map[string]struct {
+		global bool
+		tokens []string
+		expErr bool
+	}{
+		"global": {
+			global: true,
+		},
+		"tokens": {
+			tokens: []string{"0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", "stake"},
+		},
+		"no tokens": {
+			expErr: true,
+		},
+		"global with tokens": {
+			global: true,
+			tokens: []string{"stake"},
+			expErr: true,
+		},
+		"empty token": {
+			tokens: []string{" "},
+			expErr: true,
+		},
+		"duplicate token": {
+			tokens: []string{"stake", "stake"},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			p := types.NewBridgePauseProposal("title", "description", true, spec.global, spec.tokens)
+			err := p.ValidateBasic()
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}